@@ -0,0 +1,17 @@
+package assignments
+
+const (
+	ROLE_LEAD      = "lead"
+	ROLE_ASSISTANT = "assistant"
+)
+
+type Assignment struct {
+	ProfessorID string
+	CourseID    string
+	Role        string
+}
+
+// ValidRole reports whether role is one a professor can hold on a course.
+func ValidRole(role string) bool {
+	return role == ROLE_LEAD || role == ROLE_ASSISTANT
+}
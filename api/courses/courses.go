@@ -1,6 +1,29 @@
 package courses
 
+import (
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/validation"
+)
+
 type Course struct {
-	ID   string
-	Name string
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Filter narrows a list of courses, the zero value matches every course.
+type Filter struct {
+	NamePrefix string
+}
+
+// Cursor returns the position right after the course in a list sorted by sort.
+func (course *Course) Cursor(sort string) *pagination.Cursor {
+	return pagination.NameCursor(course.Name, course.ID)
+}
+
+// Validate reports every field of the course breaking its rule as validation.Errors.
+func (course *Course) Validate() error {
+	errs := validation.Errors{}
+	errs.Add("id", validation.ID(course.ID))
+	errs.Add("name", validation.CourseName(course.Name))
+	return errs.Err()
 }
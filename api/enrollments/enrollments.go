@@ -0,0 +1,6 @@
+package enrollments
+
+type Enrollment struct {
+	StudentID string
+	CourseID  string
+}
@@ -0,0 +1,122 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const (
+	SORT_NAME   = "name"
+	SORT_AGE    = "age"
+	SORT_SALARY = "salary"
+
+	DEFAULT_LIMIT uint = 20
+	MAX_LIMIT     uint = 100
+)
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidSort   = errors.New("invalid sort key")
+)
+
+// Page selects one page of a list sorted ascending by Sort, then by id.
+// Cursor is empty for the first page and otherwise the cursor returned with the previous page.
+type Page struct {
+	Sort   string
+	Cursor string
+	Limit  uint
+}
+
+// Cursor is the position after which a page starts: the sort key and id of the last item of the previous page.
+type Cursor struct {
+	Key string
+	ID  string
+}
+
+func NewPage(sort string, cursor string, limit uint) *Page {
+	return &Page{
+		Sort:   sort,
+		Cursor: cursor,
+		Limit:  limit,
+	}
+}
+
+// Size returns the page limit clamped to [1, MAX_LIMIT], DEFAULT_LIMIT when unset.
+func (page *Page) Size() uint {
+	switch {
+	case page.Limit == 0:
+		return DEFAULT_LIMIT
+	case page.Limit > MAX_LIMIT:
+		return MAX_LIMIT
+	default:
+		return page.Limit
+	}
+}
+
+// SortKey returns the page's sort key, SORT_NAME when unset, or ErrInvalidSort when it is not one of allowed.
+func (page *Page) SortKey(allowed ...string) (string, error) {
+	if page.Sort == "" {
+		return SORT_NAME, nil
+	}
+	for _, sort := range allowed {
+		if page.Sort == sort {
+			return sort, nil
+		}
+	}
+	return "", ErrInvalidSort
+}
+
+// After decodes the page's cursor, returning nil for the first page.
+func (page *Page) After() (*Cursor, error) {
+	if page.Cursor == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(page.Cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	key, id, ok := strings.Cut(string(b), "\x00")
+	if !ok {
+		return nil, ErrInvalidCursor
+	}
+	return &Cursor{Key: key, ID: id}, nil
+}
+
+func (cursor *Cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor.Key + "\x00" + cursor.ID))
+}
+
+// Value returns the cursor key typed for comparison against the sort column.
+func (cursor *Cursor) Value(sort string) (any, error) {
+	switch sort {
+	case SORT_AGE:
+		age, err := strconv.ParseUint(cursor.Key, 10, 8)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		return age, nil
+	case SORT_SALARY:
+		salary, err := strconv.ParseFloat(cursor.Key, 64)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		return salary, nil
+	default:
+		return cursor.Key, nil
+	}
+}
+
+// NameCursor, AgeCursor and SalaryCursor build the cursor that follows an item with the given key.
+func NameCursor(name string, id string) *Cursor {
+	return &Cursor{Key: name, ID: id}
+}
+
+func AgeCursor(age uint8, id string) *Cursor {
+	return &Cursor{Key: strconv.FormatUint(uint64(age), 10), ID: id}
+}
+
+func SalaryCursor(salary float64, id string) *Cursor {
+	return &Cursor{Key: strconv.FormatFloat(salary, 'g', -1, 64), ID: id}
+}
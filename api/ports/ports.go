@@ -1,55 +1,209 @@
 package ports
 
 import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/xHappyface/school/api/assignments"
 	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/enrollments"
+	"github.com/xHappyface/school/api/pagination"
 	"github.com/xHappyface/school/api/professors"
 	"github.com/xHappyface/school/api/students"
 	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/memory_db"
 	"github.com/xHappyface/school/pkg/mysql_db"
+	"github.com/xHappyface/school/pkg/postgres_db"
+	"github.com/xHappyface/school/pkg/sqlite_db"
+)
+
+const (
+	BACKEND_MYSQL    = "mysql"
+	BACKEND_POSTGRES = "postgres"
+	BACKEND_SQLITE   = "sqlite"
+	BACKEND_MEMORY   = "memory"
+)
+
+var (
+	ErrInvalidBackend = errors.New("invalid storage backend")
 )
 
 type CourseRepository interface {
-	Create(*courses.Course) error
-	ReadByID(id string) (*courses.Course, error)
-	ReadByName(name string) (*courses.Course, error)
-	Update(*courses.Course) error
-	DeleteByID(id string) error
+	Create(context.Context, *courses.Course) error
+	ReadByID(ctx context.Context, id string) (*courses.Course, error)
+	ReadByName(ctx context.Context, name string) (*courses.Course, error)
+	List(context.Context, *courses.Filter, *pagination.Page) ([]*courses.Course, string, error)
+	Update(context.Context, *courses.Course) error
+	DeleteByID(ctx context.Context, id string) error
 }
 
 type ProfessorRepository interface {
-	Create(*professors.Professor) error
-	ReadByID(id string) (*professors.Professor, error)
-	ReadByName(name string) (*professors.Professor, error)
-	Update(*professors.Professor) error
-	DeleteByID(id string) error
+	Create(context.Context, *professors.Professor) error
+	ReadByID(ctx context.Context, id string) (*professors.Professor, error)
+	ReadByName(ctx context.Context, name string) (*professors.Professor, error)
+	List(context.Context, *professors.Filter, *pagination.Page) ([]*professors.Professor, string, error)
+	Update(context.Context, *professors.Professor) error
+	DeleteByID(ctx context.Context, id string) error
 }
 
 type StudentRepository interface {
-	Create(*students.Student) error
-	ReadByID(id string) (*students.Student, error)
-	ReadByName(name string) (*students.Student, error)
-	Update(*students.Student) error
-	DeleteByID(id string) error
+	Create(context.Context, *students.Student) error
+	ReadByID(ctx context.Context, id string) (*students.Student, error)
+	ReadByName(ctx context.Context, name string) (*students.Student, error)
+	List(context.Context, *students.Filter, *pagination.Page) ([]*students.Student, string, error)
+	Update(context.Context, *students.Student) error
+	DeleteByID(ctx context.Context, id string) error
+}
+
+type EnrollmentRepository interface {
+	Enroll(context.Context, *enrollments.Enrollment) error
+	Drop(ctx context.Context, studentID string, courseID string) error
+	ListCoursesByStudent(ctx context.Context, studentID string) ([]*courses.Course, error)
+	ListStudentsByCourse(ctx context.Context, courseID string) ([]*students.Student, error)
+}
+
+type AssignmentRepository interface {
+	Assign(context.Context, *assignments.Assignment) error
+	Unassign(ctx context.Context, professorID string, courseID string) error
+	ListByProfessor(ctx context.Context, professorID string) ([]*assignments.Assignment, error)
+	ListByCourse(ctx context.Context, courseID string) ([]*assignments.Assignment, error)
 }
 
 type SchoolService struct {
-	DB            *mysql_db.School
-	CourseRepo    CourseRepository
-	ProfessorRepo ProfessorRepository
-	StudentRepo   StudentRepository
+	DB             io.Closer
+	CourseRepo     CourseRepository
+	ProfessorRepo  ProfessorRepository
+	StudentRepo    StudentRepository
+	EnrollmentRepo EnrollmentRepository
+	AssignmentRepo AssignmentRepository
+	// Migrator is nil for backends that manage their own schema.
+	Migrator *mysql_db.Migrator
+
+	begin func(context.Context) (*UnitOfWork, error)
 }
 
 // NewSchoolService returns the address of a new school service with a repo for Courses, Professors, and Students
-// with the given logger and milliseconds passed as the context time.
-func NewSchoolService(l *logger.SchoolLogger, milliseconds uint) (*SchoolService, error) {
-	db, err := mysql_db.NewSchoolDB(l)
+// stored in the given backend, with the given logger and milliseconds passed as the context time.
+// source is backend specific: the database file for sqlite, the connection url for postgres and
+// the data source name for mysql (both empty to connect with DB_USER and DB_PASS), ignored for memory.
+func NewSchoolService(l *logger.SchoolLogger, backend string, source string, milliseconds uint) (*SchoolService, error) {
+	switch backend {
+	case BACKEND_MYSQL:
+		return newMySQLSchoolService(l, source, milliseconds)
+	case BACKEND_POSTGRES:
+		return newPostgresSchoolService(l, source, milliseconds)
+	case BACKEND_SQLITE:
+		return newSQLiteSchoolService(l, source, milliseconds)
+	case BACKEND_MEMORY:
+		return newMemorySchoolService(l), nil
+	default:
+		return new(SchoolService), ErrInvalidBackend
+	}
+}
+
+func newMySQLSchoolService(l *logger.SchoolLogger, source string, milliseconds uint) (*SchoolService, error) {
+	db, err := mysql_db.NewSchoolDB(l, source)
 	if err != nil {
 		return new(SchoolService), err
 	}
-	return &SchoolService{
-		DB:            db,
-		CourseRepo:    mysql_db.NewSQLCourseRepository(db, milliseconds, l),
-		ProfessorRepo: mysql_db.NewSQLProfessorRepository(db, milliseconds, l),
-		StudentRepo:   mysql_db.NewSQLStudentRepository(db, milliseconds, l),
-	}, nil
+	sch := mysqlRepositories(db, milliseconds, l)
+	sch.Migrator = mysql_db.NewMigrator(db, milliseconds, l)
+	return sch, nil
+}
+
+func mysqlRepositories(db *mysql_db.School, milliseconds uint, l *logger.SchoolLogger) *SchoolService {
+	sch := &SchoolService{
+		DB:             db,
+		CourseRepo:     mysql_db.NewSQLCourseRepository(db, milliseconds, l),
+		ProfessorRepo:  mysql_db.NewSQLProfessorRepository(db, milliseconds, l),
+		StudentRepo:    mysql_db.NewSQLStudentRepository(db, milliseconds, l),
+		EnrollmentRepo: mysql_db.NewSQLEnrollmentRepository(db, milliseconds, l),
+		AssignmentRepo: mysql_db.NewSQLAssignmentRepository(db, milliseconds, l),
+	}
+	sch.begin = func(ctx context.Context) (*UnitOfWork, error) {
+		tx, err := db.Begin(ctx)
+		if err != nil {
+			return new(UnitOfWork), err
+		}
+		return newUnitOfWork(mysqlRepositories(tx, milliseconds, l), tx), nil
+	}
+	return sch
+}
+
+func newPostgresSchoolService(l *logger.SchoolLogger, source string, milliseconds uint) (*SchoolService, error) {
+	db, err := postgres_db.NewSchoolDB(l, source)
+	if err != nil {
+		return new(SchoolService), err
+	}
+	return postgresRepositories(db, milliseconds, l), nil
+}
+
+func postgresRepositories(db *postgres_db.School, milliseconds uint, l *logger.SchoolLogger) *SchoolService {
+	sch := &SchoolService{
+		DB:             db,
+		CourseRepo:     postgres_db.NewPostgresCourseRepository(db, milliseconds, l),
+		ProfessorRepo:  postgres_db.NewPostgresProfessorRepository(db, milliseconds, l),
+		StudentRepo:    postgres_db.NewPostgresStudentRepository(db, milliseconds, l),
+		EnrollmentRepo: postgres_db.NewPostgresEnrollmentRepository(db, milliseconds, l),
+		AssignmentRepo: postgres_db.NewPostgresAssignmentRepository(db, milliseconds, l),
+	}
+	sch.begin = func(ctx context.Context) (*UnitOfWork, error) {
+		tx, err := db.Begin(ctx)
+		if err != nil {
+			return new(UnitOfWork), err
+		}
+		return newUnitOfWork(postgresRepositories(tx, milliseconds, l), tx), nil
+	}
+	return sch
+}
+
+func newSQLiteSchoolService(l *logger.SchoolLogger, path string, milliseconds uint) (*SchoolService, error) {
+	db, err := sqlite_db.NewSchoolDB(l, path)
+	if err != nil {
+		return new(SchoolService), err
+	}
+	return sqliteRepositories(db, milliseconds, l), nil
+}
+
+func sqliteRepositories(db *sqlite_db.School, milliseconds uint, l *logger.SchoolLogger) *SchoolService {
+	sch := &SchoolService{
+		DB:             db,
+		CourseRepo:     sqlite_db.NewSQLiteCourseRepository(db, milliseconds, l),
+		ProfessorRepo:  sqlite_db.NewSQLiteProfessorRepository(db, milliseconds, l),
+		StudentRepo:    sqlite_db.NewSQLiteStudentRepository(db, milliseconds, l),
+		EnrollmentRepo: sqlite_db.NewSQLiteEnrollmentRepository(db, milliseconds, l),
+		AssignmentRepo: sqlite_db.NewSQLiteAssignmentRepository(db, milliseconds, l),
+	}
+	sch.begin = func(ctx context.Context) (*UnitOfWork, error) {
+		tx, err := db.Begin(ctx)
+		if err != nil {
+			return new(UnitOfWork), err
+		}
+		return newUnitOfWork(sqliteRepositories(tx, milliseconds, l), tx), nil
+	}
+	return sch
+}
+
+func newMemorySchoolService(l *logger.SchoolLogger) *SchoolService {
+	return memoryRepositories(memory_db.NewSchoolDB(l), l)
+}
+
+func memoryRepositories(db *memory_db.School, l *logger.SchoolLogger) *SchoolService {
+	sch := &SchoolService{
+		DB:             db,
+		CourseRepo:     memory_db.NewMemoryCourseRepository(db, l),
+		ProfessorRepo:  memory_db.NewMemoryProfessorRepository(db, l),
+		StudentRepo:    memory_db.NewMemoryStudentRepository(db, l),
+		EnrollmentRepo: memory_db.NewMemoryEnrollmentRepository(db, l),
+		AssignmentRepo: memory_db.NewMemoryAssignmentRepository(db, l),
+	}
+	sch.begin = func(ctx context.Context) (*UnitOfWork, error) {
+		tx, err := db.Begin(ctx)
+		if err != nil {
+			return new(UnitOfWork), err
+		}
+		return newUnitOfWork(memoryRepositories(tx, l), tx), nil
+	}
+	return sch
 }
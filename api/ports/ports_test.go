@@ -0,0 +1,675 @@
+package ports
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/assignments"
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/enrollments"
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+// The repository conformance tests run the same scenarios against every backend
+// at hand: memory, sqlite in a temporary file, postgres when TEST_POSTGRES_URL names
+// a database to use and mysql, migrated first, when TEST_MYSQL_DSN does. Names start with a prefix unique to the run, so a
+// shared database may hold other rows, and the objects created are deleted after.
+
+const TEST_MILLISECONDS uint = 10_000
+
+func testLogger(t *testing.T) *logger.SchoolLogger {
+	l := logger.New()
+	err := l.Configure(&logger.Config{Level: logger.LOG_LEVEL_ERR, Format: logger.FORMAT_TEXT, Sinks: []string{logger.SINK_STDERR}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func testBackends(t *testing.T) map[string]func(t *testing.T) *SchoolService {
+	backends := map[string]func(t *testing.T) *SchoolService{
+		BACKEND_MEMORY: func(t *testing.T) *SchoolService {
+			return openBackend(t, BACKEND_MEMORY, "")
+		},
+		BACKEND_SQLITE: func(t *testing.T) *SchoolService {
+			return openBackend(t, BACKEND_SQLITE, filepath.Join(t.TempDir(), "school.db"))
+		},
+	}
+	if source := os.Getenv("TEST_POSTGRES_URL"); source != "" {
+		backends[BACKEND_POSTGRES] = func(t *testing.T) *SchoolService {
+			return openBackend(t, BACKEND_POSTGRES, source)
+		}
+	} else {
+		t.Log("TEST_POSTGRES_URL unset, skipping postgres")
+	}
+	if source := os.Getenv("TEST_MYSQL_DSN"); source != "" {
+		backends[BACKEND_MYSQL] = func(t *testing.T) *SchoolService {
+			sch := openBackend(t, BACKEND_MYSQL, source)
+			if err := sch.Migrator.Up(context.Background()); err != nil {
+				t.Fatalf("migrating mysql: %v", err)
+			}
+			return sch
+		}
+	} else {
+		t.Log("TEST_MYSQL_DSN unset, skipping mysql")
+	}
+	return backends
+}
+
+func openBackend(t *testing.T, backend string, source string) *SchoolService {
+	sch, err := NewSchoolService(testLogger(t), backend, source, TEST_MILLISECONDS)
+	if err != nil {
+		t.Fatalf("opening %s: %v", backend, err)
+	}
+	t.Cleanup(func() { sch.DB.Close() })
+	return sch
+}
+
+// testPrefix returns a name prefix of capital letters unique to the run, valid in course and person names.
+func testPrefix() string {
+	letters := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return 'A' + (r - '0')
+		}
+		return 'K' + (r - 'a')
+	}, strings.ReplaceAll(uuid.NewString(), "-", "")[:10])
+	return "T" + letters + " "
+}
+
+func TestRepositories(t *testing.T) {
+	for name, open := range testBackends(t) {
+		open := open
+		t.Run(name, func(t *testing.T) {
+			sch := open(t)
+			t.Run("courses", func(t *testing.T) { testCourses(t, sch) })
+			t.Run("professors", func(t *testing.T) { testProfessors(t, sch) })
+			t.Run("students", func(t *testing.T) { testStudents(t, sch) })
+			t.Run("list courses", func(t *testing.T) { testListCourses(t, sch) })
+			t.Run("list professors", func(t *testing.T) { testListProfessors(t, sch) })
+			t.Run("list students", func(t *testing.T) { testListStudents(t, sch) })
+			t.Run("enrollments", func(t *testing.T) { testEnrollments(t, sch) })
+			t.Run("assignments", func(t *testing.T) { testAssignments(t, sch) })
+			t.Run("concurrent units of work", func(t *testing.T) { testConcurrentUnitsOfWork(t, sch) })
+			// the memory backend leaves unique names to its callers, which check them under its lock
+			if name != BACKEND_MEMORY {
+				t.Run("duplicate names", func(t *testing.T) { testDuplicateNames(t, sch) })
+			}
+		})
+	}
+}
+
+// TestHandMadeTables runs the crud scenarios on sqlite tables made before the schema with
+// their columns in another order, which `create table if not exists` leaves as they are.
+func TestHandMadeTables(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "school.db")
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec(`
+create table courses (name text not null, id text primary key);
+create table professors (
+	if_received_bonus integer not null default 0,
+	salary            real not null,
+	phone             integer not null,
+	address           text not null,
+	age               integer not null,
+	name              text not null,
+	id                text primary key
+);
+create table students (
+	if_on_probation  integer not null default 0,
+	if_international integer not null default 0,
+	phone            integer not null,
+	address          text not null,
+	age              integer not null,
+	name             text not null,
+	id               text primary key
+);`)
+	if closeErr := db.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	sch := openBackend(t, BACKEND_SQLITE, path)
+	t.Run("courses", func(t *testing.T) { testCourses(t, sch) })
+	t.Run("professors", func(t *testing.T) { testProfessors(t, sch) })
+	t.Run("students", func(t *testing.T) { testStudents(t, sch) })
+}
+
+// repository is the part of the course, professor and student repositories testCRUD exercises.
+type repository[T any] interface {
+	Create(context.Context, T) error
+	ReadByID(ctx context.Context, id string) (T, error)
+	ReadByName(ctx context.Context, name string) (T, error)
+	Update(context.Context, T) error
+	DeleteByID(ctx context.Context, id string) error
+}
+
+// testCRUD creates obj, reads it back by id and name, replaces it by updated and deletes it,
+// checking the errors reported for objects that do not exist on the way.
+func testCRUD[T any](t *testing.T, repo repository[T], obj T, updated T, id string, name string, missing T) {
+	ctx := context.Background()
+	if err := repo.Create(ctx, obj); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	t.Cleanup(func() { repo.DeleteByID(ctx, id) })
+	if err := repo.Create(ctx, obj); !(errors.Is(err, mysql_db.ErrDuplicateKey)) {
+		t.Errorf("Create of a duplicate id: got %v, want %v", err, mysql_db.ErrDuplicateKey)
+	}
+	got, err := repo.ReadByID(ctx, id)
+	if err != nil || !(reflect.DeepEqual(got, obj)) {
+		t.Errorf("ReadByID: got %+v, %v, want %+v", got, err, obj)
+	}
+	got, err = repo.ReadByName(ctx, name)
+	if err != nil || !(reflect.DeepEqual(got, obj)) {
+		t.Errorf("ReadByName: got %+v, %v, want %+v", got, err, obj)
+	}
+	if _, err = repo.ReadByID(ctx, uuid.NewString()); !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+		t.Errorf("ReadByID of a missing id: got %v, want %v", err, mysql_db.ErrZeroRowsRetrieved)
+	}
+	if _, err = repo.ReadByName(ctx, name+"X"); !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+		t.Errorf("ReadByName of a missing name: got %v, want %v", err, mysql_db.ErrZeroRowsRetrieved)
+	}
+	if err = repo.Update(ctx, updated); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if err = repo.Update(ctx, updated); err != nil {
+		t.Errorf("Update to unchanged values: %v", err)
+	}
+	got, err = repo.ReadByID(ctx, id)
+	if err != nil || !(reflect.DeepEqual(got, updated)) {
+		t.Errorf("ReadByID after Update: got %+v, %v, want %+v", got, err, updated)
+	}
+	if err = repo.Update(ctx, missing); !(errors.Is(err, mysql_db.ErrZeroRowsAffected)) {
+		t.Errorf("Update of a missing id: got %v, want %v", err, mysql_db.ErrZeroRowsAffected)
+	}
+	if err = repo.DeleteByID(ctx, id); err != nil {
+		t.Fatalf("DeleteByID: %v", err)
+	}
+	if _, err = repo.ReadByID(ctx, id); !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+		t.Errorf("ReadByID after DeleteByID: got %v, want %v", err, mysql_db.ErrZeroRowsRetrieved)
+	}
+	if err = repo.DeleteByID(ctx, id); !(errors.Is(err, mysql_db.ErrZeroRowsAffected)) {
+		t.Errorf("DeleteByID of a missing id: got %v, want %v", err, mysql_db.ErrZeroRowsAffected)
+	}
+}
+
+func testCourses(t *testing.T, sch *SchoolService) {
+	prefix := testPrefix()
+	course := &courses.Course{ID: uuid.NewString(), Name: prefix + "MATH 101"}
+	updated := &courses.Course{ID: course.ID, Name: prefix + "MATH 102"}
+	missing := &courses.Course{ID: uuid.NewString(), Name: prefix + "MATH 103"}
+	testCRUD[*courses.Course](t, sch.CourseRepo, course, updated, course.ID, course.Name, missing)
+}
+
+func testProfessors(t *testing.T, sch *SchoolService) {
+	prefix := testPrefix()
+	professor := newProfessor(prefix+"ADA", 40, 5000.5)
+	updated := *professor
+	updated.Name, updated.Age, updated.Salary, updated.IfReceivedBonus = prefix+"ADA B", 41, 6000.25, true
+	missing := newProfessor(prefix+"GRACE", 50, 1)
+	testCRUD[*professors.Professor](t, sch.ProfessorRepo, professor, &updated, professor.ID, professor.Name, missing)
+}
+
+func testStudents(t *testing.T, sch *SchoolService) {
+	prefix := testPrefix()
+	student := newStudent(prefix+"ALAN", 20, false)
+	updated := *student
+	updated.Name, updated.Age, updated.IfInternational, updated.IfOnProbation = prefix+"ALAN B", 21, true, true
+	missing := newStudent(prefix+"EDSGER", 22, false)
+	testCRUD[*students.Student](t, sch.StudentRepo, student, &updated, student.ID, student.Name, missing)
+}
+
+// testConcurrentUnitsOfWork runs units of work that read before they write all
+// at once, each of which must wait for the others to finish rather than fail.
+func testConcurrentUnitsOfWork(t *testing.T, sch *SchoolService) {
+	ctx := context.Background()
+	prefix := testPrefix()
+	const n = 20
+	errs := make(chan error, n)
+	for i := 0; i < n; i++ {
+		course := &courses.Course{ID: uuid.NewString(), Name: fmt.Sprintf("%sC%d", prefix, i)}
+		t.Cleanup(func() { sch.CourseRepo.DeleteByID(ctx, course.ID) })
+		go func() {
+			errs <- sch.InTransaction(ctx, func(uow *UnitOfWork) error {
+				if _, err := uow.CourseRepo.ReadByName(ctx, course.Name); !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+					return fmt.Errorf("ReadByName: %w", err)
+				}
+				return uow.CourseRepo.Create(ctx, course)
+			})
+		}()
+	}
+	for i := 0; i < n; i++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// testDuplicateNames checks a name taken by another row is refused with mysql_db.ErrDuplicateKey,
+// as when a concurrent statement stored it between a unit of work's check and its write.
+func testDuplicateNames(t *testing.T, sch *SchoolService) {
+	ctx := context.Background()
+	prefix := testPrefix()
+	course := &courses.Course{ID: uuid.NewString(), Name: prefix + "MATH 101"}
+	other := &courses.Course{ID: uuid.NewString(), Name: prefix + "MATH 102"}
+	for _, c := range []*courses.Course{course, other} {
+		if err := sch.CourseRepo.Create(ctx, c); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		id := c.ID
+		t.Cleanup(func() { sch.CourseRepo.DeleteByID(ctx, id) })
+	}
+	duplicate := &courses.Course{ID: uuid.NewString(), Name: course.Name}
+	if err := sch.CourseRepo.Create(ctx, duplicate); !(errors.Is(err, mysql_db.ErrDuplicateKey)) {
+		t.Errorf("Create of a taken name: got %v, want %v", err, mysql_db.ErrDuplicateKey)
+	}
+	renamed := &courses.Course{ID: other.ID, Name: course.Name}
+	if err := sch.CourseRepo.Update(ctx, renamed); !(errors.Is(err, mysql_db.ErrDuplicateKey)) {
+		t.Errorf("Update to a taken name: got %v, want %v", err, mysql_db.ErrDuplicateKey)
+	}
+}
+
+func newProfessor(name string, age uint8, salary float64) *professors.Professor {
+	return &professors.Professor{
+		ID:      uuid.NewString(),
+		Name:    name,
+		Age:     age,
+		Address: "1 College Road",
+		Phone:   5550100,
+		Salary:  salary,
+	}
+}
+
+func newStudent(name string, age uint8, international bool) *students.Student {
+	return &students.Student{
+		ID:              uuid.NewString(),
+		Name:            name,
+		Age:             age,
+		Address:         "2 Campus Lane",
+		Phone:           5550199,
+		IfInternational: international,
+	}
+}
+
+// listAll pages through list with limit and returns every item in order, checking the last page has no cursor.
+func listAll[T any](t *testing.T, list func(*pagination.Page) ([]T, string, error), sort string, limit uint) []T {
+	t.Helper()
+	all := []T{}
+	cursor := ""
+	for pages := 0; ; pages++ {
+		if pages > 100 {
+			t.Fatal("list did not end")
+		}
+		items, next, err := list(pagination.NewPage(sort, cursor, limit))
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		if uint(len(items)) > limit {
+			t.Fatalf("got %d items, more than the limit %d", len(items), limit)
+		}
+		all = append(all, items...)
+		if next == "" {
+			return all
+		}
+		if uint(len(items)) != limit {
+			t.Errorf("got a cursor after a page of %d items, limit %d", len(items), limit)
+		}
+		cursor = next
+	}
+}
+
+// checkListErrors checks list rejects an unknown sort key and a malformed cursor.
+func checkListErrors[T any](t *testing.T, list func(*pagination.Page) ([]T, string, error)) {
+	t.Helper()
+	if _, _, err := list(pagination.NewPage("phone", "", 0)); !(errors.Is(err, pagination.ErrInvalidSort)) {
+		t.Errorf("unknown sort: got %v, want %v", err, pagination.ErrInvalidSort)
+	}
+	if _, _, err := list(pagination.NewPage("", "not a cursor!", 0)); !(errors.Is(err, pagination.ErrInvalidCursor)) {
+		t.Errorf("malformed cursor: got %v, want %v", err, pagination.ErrInvalidCursor)
+	}
+}
+
+func testListCourses(t *testing.T, sch *SchoolService) {
+	ctx := context.Background()
+	prefix := testPrefix()
+	want := []*courses.Course{}
+	for _, name := range []string{"C", "A", "E", "B", "D"} {
+		course := &courses.Course{ID: uuid.NewString(), Name: prefix + name}
+		if err := sch.CourseRepo.Create(ctx, course); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { sch.CourseRepo.DeleteByID(ctx, course.ID) })
+		want = append(want, course)
+	}
+	sort.Slice(want, func(i, j int) bool { return want[i].Name < want[j].Name })
+	list := func(filter *courses.Filter) func(*pagination.Page) ([]*courses.Course, string, error) {
+		return func(page *pagination.Page) ([]*courses.Course, string, error) {
+			return sch.CourseRepo.List(ctx, filter, page)
+		}
+	}
+	for _, limit := range []uint{1, 2, 5, 100} {
+		if got := listAll(t, list(&courses.Filter{NamePrefix: prefix}), "", limit); !(reflect.DeepEqual(got, want)) {
+			t.Errorf("limit %d: got %v, want %v", limit, got, want)
+		}
+	}
+	if got := listAll(t, list(&courses.Filter{NamePrefix: prefix + "B"}), "", 10); !(reflect.DeepEqual(got, want[1:2])) {
+		t.Errorf("prefix B: got %v, want %v", got, want[1:2])
+	}
+	if got := listAll(t, list(&courses.Filter{NamePrefix: prefix[:len(prefix)-1] + "%"}), "", 10); len(got) != 0 {
+		t.Errorf("prefix with a wildcard: got %v, want none", got)
+	}
+	if _, _, err := sch.CourseRepo.List(ctx, &courses.Filter{}, pagination.NewPage(pagination.SORT_AGE, "", 0)); !(errors.Is(err, pagination.ErrInvalidSort)) {
+		t.Errorf("sort by age: got %v, want %v", err, pagination.ErrInvalidSort)
+	}
+	checkListErrors(t, list(&courses.Filter{}))
+}
+
+func testListProfessors(t *testing.T, sch *SchoolService) {
+	ctx := context.Background()
+	prefix := testPrefix()
+	all := []*professors.Professor{}
+	for i, name := range []string{"CAROL", "ALICE", "ERIN", "BOB", "DAVE"} {
+		professor := newProfessor(prefix+name, []uint8{50, 40, 50, 30, 40}[i], []float64{3000, 1000.5, 2000, 3000, 1000.5}[i])
+		professor.IfReceivedBonus = i%2 == 0
+		if err := sch.ProfessorRepo.Create(ctx, professor); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { sch.ProfessorRepo.DeleteByID(ctx, professor.ID) })
+		all = append(all, professor)
+	}
+	list := func(filter *professors.Filter) func(*pagination.Page) ([]*professors.Professor, string, error) {
+		filter.NamePrefix = prefix
+		return func(page *pagination.Page) ([]*professors.Professor, string, error) {
+			return sch.ProfessorRepo.List(ctx, filter, page)
+		}
+	}
+	sorted := func(less func(a, b *professors.Professor) bool, keep func(*professors.Professor) bool) []*professors.Professor {
+		want := []*professors.Professor{}
+		for _, professor := range all {
+			if keep(professor) {
+				want = append(want, professor)
+			}
+		}
+		sort.Slice(want, func(i, j int) bool { return less(want[i], want[j]) })
+		return want
+	}
+	byName := func(a, b *professors.Professor) bool { return a.Name < b.Name }
+	byAge := func(a, b *professors.Professor) bool { return a.Age < b.Age || (a.Age == b.Age && a.ID < b.ID) }
+	bySalary := func(a, b *professors.Professor) bool {
+		return a.Salary < b.Salary || (a.Salary == b.Salary && a.ID < b.ID)
+	}
+	every := func(*professors.Professor) bool { return true }
+	minSalary, maxSalary, bonus := 1500.0, 3000.0, true
+	tests := []struct {
+		name   string
+		filter *professors.Filter
+		sort   string
+		want   []*professors.Professor
+	}{
+		{"by name", &professors.Filter{}, "", sorted(byName, every)},
+		{"by age", &professors.Filter{}, pagination.SORT_AGE, sorted(byAge, every)},
+		{"by salary", &professors.Filter{}, pagination.SORT_SALARY, sorted(bySalary, every)},
+		{"age range", &professors.Filter{MinAge: 35, MaxAge: 45}, "", sorted(byName, func(p *professors.Professor) bool { return p.Age >= 35 && p.Age <= 45 })},
+		{"salary range", &professors.Filter{MinSalary: &minSalary, MaxSalary: &maxSalary}, pagination.SORT_SALARY, sorted(bySalary, func(p *professors.Professor) bool { return p.Salary >= minSalary && p.Salary <= maxSalary })},
+		{"bonus", &professors.Filter{IfReceivedBonus: &bonus}, pagination.SORT_AGE, sorted(byAge, func(p *professors.Professor) bool { return p.IfReceivedBonus })},
+	}
+	for _, test := range tests {
+		for _, limit := range []uint{1, 2, 100} {
+			if got := listAll(t, list(test.filter), test.sort, limit); !(reflect.DeepEqual(got, test.want)) {
+				t.Errorf("%s, limit %d: got %v, want %v", test.name, limit, got, test.want)
+			}
+		}
+	}
+	checkListErrors(t, list(&professors.Filter{}))
+}
+
+func testListStudents(t *testing.T, sch *SchoolService) {
+	ctx := context.Background()
+	prefix := testPrefix()
+	all := []*students.Student{}
+	for i, name := range []string{"CAROL", "ALICE", "ERIN", "BOB", "DAVE"} {
+		student := newStudent(prefix+name, []uint8{20, 18, 20, 25, 18}[i], i%2 == 0)
+		student.IfOnProbation = i == 3
+		if err := sch.StudentRepo.Create(ctx, student); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { sch.StudentRepo.DeleteByID(ctx, student.ID) })
+		all = append(all, student)
+	}
+	list := func(filter *students.Filter) func(*pagination.Page) ([]*students.Student, string, error) {
+		filter.NamePrefix = prefix
+		return func(page *pagination.Page) ([]*students.Student, string, error) {
+			return sch.StudentRepo.List(ctx, filter, page)
+		}
+	}
+	sorted := func(less func(a, b *students.Student) bool, keep func(*students.Student) bool) []*students.Student {
+		want := []*students.Student{}
+		for _, student := range all {
+			if keep(student) {
+				want = append(want, student)
+			}
+		}
+		sort.Slice(want, func(i, j int) bool { return less(want[i], want[j]) })
+		return want
+	}
+	byName := func(a, b *students.Student) bool { return a.Name < b.Name }
+	byAge := func(a, b *students.Student) bool { return a.Age < b.Age || (a.Age == b.Age && a.ID < b.ID) }
+	every := func(*students.Student) bool { return true }
+	international, probation := true, false
+	tests := []struct {
+		name   string
+		filter *students.Filter
+		sort   string
+		want   []*students.Student
+	}{
+		{"by name", &students.Filter{}, "", sorted(byName, every)},
+		{"by age", &students.Filter{}, pagination.SORT_AGE, sorted(byAge, every)},
+		{"age range", &students.Filter{MinAge: 19, MaxAge: 24}, pagination.SORT_AGE, sorted(byAge, func(s *students.Student) bool { return s.Age >= 19 && s.Age <= 24 })},
+		{"international", &students.Filter{IfInternational: &international}, "", sorted(byName, func(s *students.Student) bool { return s.IfInternational })},
+		{"not on probation", &students.Filter{IfOnProbation: &probation}, "", sorted(byName, func(s *students.Student) bool { return !(s.IfOnProbation) })},
+	}
+	for _, test := range tests {
+		for _, limit := range []uint{1, 2, 100} {
+			if got := listAll(t, list(test.filter), test.sort, limit); !(reflect.DeepEqual(got, test.want)) {
+				t.Errorf("%s, limit %d: got %v, want %v", test.name, limit, got, test.want)
+			}
+		}
+	}
+	if _, _, err := sch.StudentRepo.List(ctx, &students.Filter{}, pagination.NewPage(pagination.SORT_SALARY, "", 0)); !(errors.Is(err, pagination.ErrInvalidSort)) {
+		t.Errorf("sort by salary: got %v, want %v", err, pagination.ErrInvalidSort)
+	}
+	checkListErrors(t, list(&students.Filter{}))
+}
+
+func testEnrollments(t *testing.T, sch *SchoolService) {
+	ctx := context.Background()
+	prefix := testPrefix()
+	course := &courses.Course{ID: uuid.NewString(), Name: prefix + "PHYSICS"}
+	other := &courses.Course{ID: uuid.NewString(), Name: prefix + "CHEMISTRY"}
+	student := newStudent(prefix+"MARIE", 19, true)
+	for _, c := range []*courses.Course{course, other} {
+		if err := sch.CourseRepo.Create(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+		c := c
+		t.Cleanup(func() { sch.CourseRepo.DeleteByID(ctx, c.ID) })
+	}
+	if err := sch.StudentRepo.Create(ctx, student); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sch.StudentRepo.DeleteByID(ctx, student.ID) })
+
+	for _, c := range []*courses.Course{course, other} {
+		if err := sch.EnrollmentRepo.Enroll(ctx, &enrollments.Enrollment{StudentID: student.ID, CourseID: c.ID}); err != nil {
+			t.Fatalf("Enroll: %v", err)
+		}
+	}
+	if err := sch.EnrollmentRepo.Enroll(ctx, &enrollments.Enrollment{StudentID: student.ID, CourseID: course.ID}); err == nil {
+		t.Error("Enroll twice succeeded")
+	}
+	if err := sch.EnrollmentRepo.Enroll(ctx, &enrollments.Enrollment{StudentID: uuid.NewString(), CourseID: course.ID}); err == nil {
+		t.Error("Enroll of a missing student succeeded")
+	}
+	if err := sch.EnrollmentRepo.Enroll(ctx, &enrollments.Enrollment{StudentID: student.ID, CourseID: uuid.NewString()}); err == nil {
+		t.Error("Enroll in a missing course succeeded")
+	}
+	checkCourseIDs(t, sch, student.ID, course.ID, other.ID)
+	checkStudentIDs(t, sch, course.ID, student.ID)
+
+	if err := sch.EnrollmentRepo.Drop(ctx, student.ID, other.ID); err != nil {
+		t.Errorf("Drop: %v", err)
+	}
+	if err := sch.EnrollmentRepo.Drop(ctx, student.ID, other.ID); !(errors.Is(err, mysql_db.ErrZeroRowsAffected)) {
+		t.Errorf("Drop twice: got %v, want %v", err, mysql_db.ErrZeroRowsAffected)
+	}
+	checkCourseIDs(t, sch, student.ID, course.ID)
+
+	// deleting the course takes its enrollments with it
+	if err := sch.EnrollmentRepo.Enroll(ctx, &enrollments.Enrollment{StudentID: student.ID, CourseID: other.ID}); err != nil {
+		t.Fatalf("Enroll again: %v", err)
+	}
+	if err := sch.CourseRepo.DeleteByID(ctx, other.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkCourseIDs(t, sch, student.ID, course.ID)
+	if err := sch.StudentRepo.DeleteByID(ctx, student.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkStudentIDs(t, sch, course.ID)
+}
+
+func testAssignments(t *testing.T, sch *SchoolService) {
+	ctx := context.Background()
+	prefix := testPrefix()
+	course := &courses.Course{ID: uuid.NewString(), Name: prefix + "BIOLOGY"}
+	other := &courses.Course{ID: uuid.NewString(), Name: prefix + "GEOLOGY"}
+	lead := newProfessor(prefix+"ROSALIND", 45, 4000)
+	assistant := newProfessor(prefix+"JAMES", 30, 2000)
+	for _, c := range []*courses.Course{course, other} {
+		if err := sch.CourseRepo.Create(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+		c := c
+		t.Cleanup(func() { sch.CourseRepo.DeleteByID(ctx, c.ID) })
+	}
+	for _, p := range []*professors.Professor{lead, assistant} {
+		if err := sch.ProfessorRepo.Create(ctx, p); err != nil {
+			t.Fatal(err)
+		}
+		p := p
+		t.Cleanup(func() { sch.ProfessorRepo.DeleteByID(ctx, p.ID) })
+	}
+
+	assigned := []*assignments.Assignment{
+		{ProfessorID: lead.ID, CourseID: course.ID, Role: assignments.ROLE_LEAD},
+		{ProfessorID: lead.ID, CourseID: other.ID, Role: assignments.ROLE_LEAD},
+		{ProfessorID: assistant.ID, CourseID: course.ID, Role: assignments.ROLE_ASSISTANT},
+	}
+	for _, a := range assigned {
+		if err := sch.AssignmentRepo.Assign(ctx, a); err != nil {
+			t.Fatalf("Assign: %v", err)
+		}
+	}
+	if err := sch.AssignmentRepo.Assign(ctx, assigned[0]); err == nil {
+		t.Error("Assign twice succeeded")
+	}
+	if err := sch.AssignmentRepo.Assign(ctx, &assignments.Assignment{ProfessorID: uuid.NewString(), CourseID: course.ID, Role: assignments.ROLE_LEAD}); err == nil {
+		t.Error("Assign of a missing professor succeeded")
+	}
+	checkAssignments(t, "ListByProfessor", sch.AssignmentRepo.ListByProfessor, lead.ID, assigned[0], assigned[1])
+	checkAssignments(t, "ListByCourse", sch.AssignmentRepo.ListByCourse, course.ID, assigned[0], assigned[2])
+
+	if err := sch.AssignmentRepo.Unassign(ctx, assistant.ID, course.ID); err != nil {
+		t.Errorf("Unassign: %v", err)
+	}
+	if err := sch.AssignmentRepo.Unassign(ctx, assistant.ID, course.ID); !(errors.Is(err, mysql_db.ErrZeroRowsAffected)) {
+		t.Errorf("Unassign twice: got %v, want %v", err, mysql_db.ErrZeroRowsAffected)
+	}
+	checkAssignments(t, "ListByCourse", sch.AssignmentRepo.ListByCourse, course.ID, assigned[0])
+
+	// deleting a course or professor takes their assignments with them
+	if err := sch.CourseRepo.DeleteByID(ctx, other.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkAssignments(t, "ListByProfessor", sch.AssignmentRepo.ListByProfessor, lead.ID, assigned[0])
+	if err := sch.ProfessorRepo.DeleteByID(ctx, lead.ID); err != nil {
+		t.Fatal(err)
+	}
+	checkAssignments(t, "ListByCourse", sch.AssignmentRepo.ListByCourse, course.ID)
+}
+
+func checkCourseIDs(t *testing.T, sch *SchoolService, studentID string, want ...string) {
+	t.Helper()
+	list, err := sch.EnrollmentRepo.ListCoursesByStudent(context.Background(), studentID)
+	if err != nil {
+		t.Fatalf("ListCoursesByStudent: %v", err)
+	}
+	got := []string{}
+	for _, course := range list {
+		got = append(got, course.ID)
+	}
+	checkIDs(t, "ListCoursesByStudent", got, want)
+}
+
+func checkStudentIDs(t *testing.T, sch *SchoolService, courseID string, want ...string) {
+	t.Helper()
+	list, err := sch.EnrollmentRepo.ListStudentsByCourse(context.Background(), courseID)
+	if err != nil {
+		t.Fatalf("ListStudentsByCourse: %v", err)
+	}
+	got := []string{}
+	for _, student := range list {
+		got = append(got, student.ID)
+	}
+	checkIDs(t, "ListStudentsByCourse", got, want)
+}
+
+// checkIDs compares the ids in any order.
+func checkIDs(t *testing.T, name string, got []string, want []string) {
+	t.Helper()
+	if want == nil {
+		want = []string{}
+	}
+	sort.Strings(got)
+	sort.Strings(want)
+	if !(reflect.DeepEqual(got, want)) {
+		t.Errorf("%s: got %v, want %v", name, got, want)
+	}
+}
+
+func checkAssignments(t *testing.T, name string, list func(context.Context, string) ([]*assignments.Assignment, error), id string, want ...*assignments.Assignment) {
+	t.Helper()
+	got, err := list(context.Background(), id)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	key := func(list []*assignments.Assignment) func(i, j int) bool {
+		return func(i, j int) bool {
+			return list[i].ProfessorID+list[i].CourseID < list[j].ProfessorID+list[j].CourseID
+		}
+	}
+	sort.Slice(got, key(got))
+	sort.Slice(want, key(want))
+	if want == nil {
+		want = []*assignments.Assignment{}
+	}
+	if !(reflect.DeepEqual(got, want)) {
+		t.Errorf("%s: got %v, want %v", name, got, want)
+	}
+}
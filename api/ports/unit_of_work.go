@@ -0,0 +1,58 @@
+package ports
+
+import (
+	"context"
+	"errors"
+)
+
+// Transaction is the commit and rollback half of a unit of work, provided by the storage backend.
+type Transaction interface {
+	Commit() error
+	Rollback() error
+}
+
+// UnitOfWork is a SchoolService whose repositories all run inside one transaction.
+type UnitOfWork struct {
+	*SchoolService
+	tx Transaction
+}
+
+// joinedTransaction is the transaction of a unit of work begun inside another one,
+// committing or rolling back is left to the outer unit.
+type joinedTransaction struct{}
+
+func (joinedTransaction) Commit() error   { return nil }
+func (joinedTransaction) Rollback() error { return nil }
+
+func newUnitOfWork(sch *SchoolService, tx Transaction) *UnitOfWork {
+	uow := &UnitOfWork{SchoolService: sch, tx: tx}
+	sch.begin = func(ctx context.Context) (*UnitOfWork, error) {
+		return &UnitOfWork{SchoolService: sch, tx: joinedTransaction{}}, nil
+	}
+	return uow
+}
+
+// Begin starts a unit of work. Beginning from the repositories of a unit of work joins it instead.
+func (sch *SchoolService) Begin(ctx context.Context) (*UnitOfWork, error) {
+	return sch.begin(ctx)
+}
+
+// InTransaction runs fn in a unit of work, committing when fn succeeds and rolling back otherwise.
+func (sch *SchoolService) InTransaction(ctx context.Context, fn func(*UnitOfWork) error) error {
+	uow, err := sch.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	if err = fn(uow); err != nil {
+		return errors.Join(err, uow.Rollback())
+	}
+	return uow.Commit()
+}
+
+func (uow *UnitOfWork) Commit() error {
+	return uow.tx.Commit()
+}
+
+func (uow *UnitOfWork) Rollback() error {
+	return uow.tx.Rollback()
+}
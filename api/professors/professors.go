@@ -1,21 +1,51 @@
 package professors
 
 import (
-	"fmt"
-	"io"
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/validation"
 )
 
 type Professor struct {
-	ID              string
-	Name            string
-	Age             uint8
-	Address         string
-	Phone           uint
-	Salary          float64
-	IfReceivedBonus bool
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	Age             uint8   `json:"age"`
+	Address         string  `json:"address"`
+	Phone           uint    `json:"phone"`
+	Salary          float64 `json:"salary"`
+	IfReceivedBonus bool    `json:"if_received_bonus"`
 }
 
-func NewProfessor(r io.Reader, w io.Writer) error {
-	fmt.Fprintln(w, "New professor created.")
-	return nil
+// Filter narrows a list of professors, the zero value matches every professor.
+// Zero ages and nil fields are not applied.
+type Filter struct {
+	NamePrefix      string
+	MinAge          uint8
+	MaxAge          uint8
+	MinSalary       *float64
+	MaxSalary       *float64
+	IfReceivedBonus *bool
+}
+
+// Cursor returns the position right after the professor in a list sorted by sort.
+func (professor *Professor) Cursor(sort string) *pagination.Cursor {
+	switch sort {
+	case pagination.SORT_AGE:
+		return pagination.AgeCursor(professor.Age, professor.ID)
+	case pagination.SORT_SALARY:
+		return pagination.SalaryCursor(professor.Salary, professor.ID)
+	default:
+		return pagination.NameCursor(professor.Name, professor.ID)
+	}
+}
+
+// Validate reports every field of the professor breaking its rule as validation.Errors.
+func (professor *Professor) Validate() error {
+	errs := validation.Errors{}
+	errs.Add("id", validation.ID(professor.ID))
+	errs.Add("name", validation.PersonName(professor.Name))
+	errs.Add("age", validation.Age(professor.Age))
+	errs.Add("address", validation.Address(professor.Address))
+	errs.Add("phone", validation.Phone(professor.Phone))
+	errs.Add("salary", validation.Salary(professor.Salary))
+	return errs.Err()
 }
@@ -0,0 +1,846 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: school.proto
+
+package schoolpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	School_CreateCourse_FullMethodName     = "/school.v1.School/CreateCourse"
+	School_GetCourse_FullMethodName        = "/school.v1.School/GetCourse"
+	School_UpdateCourse_FullMethodName     = "/school.v1.School/UpdateCourse"
+	School_DeleteCourse_FullMethodName     = "/school.v1.School/DeleteCourse"
+	School_ListCourses_FullMethodName      = "/school.v1.School/ListCourses"
+	School_StreamCourses_FullMethodName    = "/school.v1.School/StreamCourses"
+	School_CreateProfessor_FullMethodName  = "/school.v1.School/CreateProfessor"
+	School_GetProfessor_FullMethodName     = "/school.v1.School/GetProfessor"
+	School_UpdateProfessor_FullMethodName  = "/school.v1.School/UpdateProfessor"
+	School_DeleteProfessor_FullMethodName  = "/school.v1.School/DeleteProfessor"
+	School_ListProfessors_FullMethodName   = "/school.v1.School/ListProfessors"
+	School_StreamProfessors_FullMethodName = "/school.v1.School/StreamProfessors"
+	School_CreateStudent_FullMethodName    = "/school.v1.School/CreateStudent"
+	School_GetStudent_FullMethodName       = "/school.v1.School/GetStudent"
+	School_UpdateStudent_FullMethodName    = "/school.v1.School/UpdateStudent"
+	School_DeleteStudent_FullMethodName    = "/school.v1.School/DeleteStudent"
+	School_ListStudents_FullMethodName     = "/school.v1.School/ListStudents"
+	School_StreamStudents_FullMethodName   = "/school.v1.School/StreamStudents"
+)
+
+// SchoolClient is the client API for School service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// School serves courses, professors and students. Names are normalized and
+// checked by the same rules as the cli, and ids are assigned on create.
+type SchoolClient interface {
+	CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*Course, error)
+	GetCourse(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*Course, error)
+	UpdateCourse(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*Course, error)
+	DeleteCourse(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*DeleteCourseResponse, error)
+	ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error)
+	// StreamCourses sends every course matching the filter, page after page.
+	StreamCourses(ctx context.Context, in *StreamCoursesRequest, opts ...grpc.CallOption) (School_StreamCoursesClient, error)
+	CreateProfessor(ctx context.Context, in *CreateProfessorRequest, opts ...grpc.CallOption) (*Professor, error)
+	GetProfessor(ctx context.Context, in *GetProfessorRequest, opts ...grpc.CallOption) (*Professor, error)
+	UpdateProfessor(ctx context.Context, in *UpdateProfessorRequest, opts ...grpc.CallOption) (*Professor, error)
+	DeleteProfessor(ctx context.Context, in *DeleteProfessorRequest, opts ...grpc.CallOption) (*DeleteProfessorResponse, error)
+	ListProfessors(ctx context.Context, in *ListProfessorsRequest, opts ...grpc.CallOption) (*ListProfessorsResponse, error)
+	StreamProfessors(ctx context.Context, in *StreamProfessorsRequest, opts ...grpc.CallOption) (School_StreamProfessorsClient, error)
+	CreateStudent(ctx context.Context, in *CreateStudentRequest, opts ...grpc.CallOption) (*Student, error)
+	GetStudent(ctx context.Context, in *GetStudentRequest, opts ...grpc.CallOption) (*Student, error)
+	UpdateStudent(ctx context.Context, in *UpdateStudentRequest, opts ...grpc.CallOption) (*Student, error)
+	DeleteStudent(ctx context.Context, in *DeleteStudentRequest, opts ...grpc.CallOption) (*DeleteStudentResponse, error)
+	ListStudents(ctx context.Context, in *ListStudentsRequest, opts ...grpc.CallOption) (*ListStudentsResponse, error)
+	StreamStudents(ctx context.Context, in *StreamStudentsRequest, opts ...grpc.CallOption) (School_StreamStudentsClient, error)
+}
+
+type schoolClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchoolClient(cc grpc.ClientConnInterface) SchoolClient {
+	return &schoolClient{cc}
+}
+
+func (c *schoolClient) CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*Course, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Course)
+	err := c.cc.Invoke(ctx, School_CreateCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) GetCourse(ctx context.Context, in *GetCourseRequest, opts ...grpc.CallOption) (*Course, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Course)
+	err := c.cc.Invoke(ctx, School_GetCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) UpdateCourse(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*Course, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Course)
+	err := c.cc.Invoke(ctx, School_UpdateCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) DeleteCourse(ctx context.Context, in *DeleteCourseRequest, opts ...grpc.CallOption) (*DeleteCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCourseResponse)
+	err := c.cc.Invoke(ctx, School_DeleteCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoursesResponse)
+	err := c.cc.Invoke(ctx, School_ListCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) StreamCourses(ctx context.Context, in *StreamCoursesRequest, opts ...grpc.CallOption) (School_StreamCoursesClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &School_ServiceDesc.Streams[0], School_StreamCourses_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &schoolStreamCoursesClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type School_StreamCoursesClient interface {
+	Recv() (*Course, error)
+	grpc.ClientStream
+}
+
+type schoolStreamCoursesClient struct {
+	grpc.ClientStream
+}
+
+func (x *schoolStreamCoursesClient) Recv() (*Course, error) {
+	m := new(Course)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *schoolClient) CreateProfessor(ctx context.Context, in *CreateProfessorRequest, opts ...grpc.CallOption) (*Professor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Professor)
+	err := c.cc.Invoke(ctx, School_CreateProfessor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) GetProfessor(ctx context.Context, in *GetProfessorRequest, opts ...grpc.CallOption) (*Professor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Professor)
+	err := c.cc.Invoke(ctx, School_GetProfessor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) UpdateProfessor(ctx context.Context, in *UpdateProfessorRequest, opts ...grpc.CallOption) (*Professor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Professor)
+	err := c.cc.Invoke(ctx, School_UpdateProfessor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) DeleteProfessor(ctx context.Context, in *DeleteProfessorRequest, opts ...grpc.CallOption) (*DeleteProfessorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProfessorResponse)
+	err := c.cc.Invoke(ctx, School_DeleteProfessor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) ListProfessors(ctx context.Context, in *ListProfessorsRequest, opts ...grpc.CallOption) (*ListProfessorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProfessorsResponse)
+	err := c.cc.Invoke(ctx, School_ListProfessors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) StreamProfessors(ctx context.Context, in *StreamProfessorsRequest, opts ...grpc.CallOption) (School_StreamProfessorsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &School_ServiceDesc.Streams[1], School_StreamProfessors_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &schoolStreamProfessorsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type School_StreamProfessorsClient interface {
+	Recv() (*Professor, error)
+	grpc.ClientStream
+}
+
+type schoolStreamProfessorsClient struct {
+	grpc.ClientStream
+}
+
+func (x *schoolStreamProfessorsClient) Recv() (*Professor, error) {
+	m := new(Professor)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *schoolClient) CreateStudent(ctx context.Context, in *CreateStudentRequest, opts ...grpc.CallOption) (*Student, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Student)
+	err := c.cc.Invoke(ctx, School_CreateStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) GetStudent(ctx context.Context, in *GetStudentRequest, opts ...grpc.CallOption) (*Student, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Student)
+	err := c.cc.Invoke(ctx, School_GetStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) UpdateStudent(ctx context.Context, in *UpdateStudentRequest, opts ...grpc.CallOption) (*Student, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Student)
+	err := c.cc.Invoke(ctx, School_UpdateStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) DeleteStudent(ctx context.Context, in *DeleteStudentRequest, opts ...grpc.CallOption) (*DeleteStudentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteStudentResponse)
+	err := c.cc.Invoke(ctx, School_DeleteStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) ListStudents(ctx context.Context, in *ListStudentsRequest, opts ...grpc.CallOption) (*ListStudentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStudentsResponse)
+	err := c.cc.Invoke(ctx, School_ListStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schoolClient) StreamStudents(ctx context.Context, in *StreamStudentsRequest, opts ...grpc.CallOption) (School_StreamStudentsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &School_ServiceDesc.Streams[2], School_StreamStudents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &schoolStreamStudentsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type School_StreamStudentsClient interface {
+	Recv() (*Student, error)
+	grpc.ClientStream
+}
+
+type schoolStreamStudentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *schoolStreamStudentsClient) Recv() (*Student, error) {
+	m := new(Student)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SchoolServer is the server API for School service.
+// All implementations must embed UnimplementedSchoolServer
+// for forward compatibility
+//
+// School serves courses, professors and students. Names are normalized and
+// checked by the same rules as the cli, and ids are assigned on create.
+type SchoolServer interface {
+	CreateCourse(context.Context, *CreateCourseRequest) (*Course, error)
+	GetCourse(context.Context, *GetCourseRequest) (*Course, error)
+	UpdateCourse(context.Context, *UpdateCourseRequest) (*Course, error)
+	DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error)
+	ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error)
+	// StreamCourses sends every course matching the filter, page after page.
+	StreamCourses(*StreamCoursesRequest, School_StreamCoursesServer) error
+	CreateProfessor(context.Context, *CreateProfessorRequest) (*Professor, error)
+	GetProfessor(context.Context, *GetProfessorRequest) (*Professor, error)
+	UpdateProfessor(context.Context, *UpdateProfessorRequest) (*Professor, error)
+	DeleteProfessor(context.Context, *DeleteProfessorRequest) (*DeleteProfessorResponse, error)
+	ListProfessors(context.Context, *ListProfessorsRequest) (*ListProfessorsResponse, error)
+	StreamProfessors(*StreamProfessorsRequest, School_StreamProfessorsServer) error
+	CreateStudent(context.Context, *CreateStudentRequest) (*Student, error)
+	GetStudent(context.Context, *GetStudentRequest) (*Student, error)
+	UpdateStudent(context.Context, *UpdateStudentRequest) (*Student, error)
+	DeleteStudent(context.Context, *DeleteStudentRequest) (*DeleteStudentResponse, error)
+	ListStudents(context.Context, *ListStudentsRequest) (*ListStudentsResponse, error)
+	StreamStudents(*StreamStudentsRequest, School_StreamStudentsServer) error
+	mustEmbedUnimplementedSchoolServer()
+}
+
+// UnimplementedSchoolServer must be embedded to have forward compatible implementations.
+type UnimplementedSchoolServer struct {
+}
+
+func (UnimplementedSchoolServer) CreateCourse(context.Context, *CreateCourseRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
+}
+func (UnimplementedSchoolServer) GetCourse(context.Context, *GetCourseRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourse not implemented")
+}
+func (UnimplementedSchoolServer) UpdateCourse(context.Context, *UpdateCourseRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourse not implemented")
+}
+func (UnimplementedSchoolServer) DeleteCourse(context.Context, *DeleteCourseRequest) (*DeleteCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
+}
+func (UnimplementedSchoolServer) ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourses not implemented")
+}
+func (UnimplementedSchoolServer) StreamCourses(*StreamCoursesRequest, School_StreamCoursesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamCourses not implemented")
+}
+func (UnimplementedSchoolServer) CreateProfessor(context.Context, *CreateProfessorRequest) (*Professor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProfessor not implemented")
+}
+func (UnimplementedSchoolServer) GetProfessor(context.Context, *GetProfessorRequest) (*Professor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfessor not implemented")
+}
+func (UnimplementedSchoolServer) UpdateProfessor(context.Context, *UpdateProfessorRequest) (*Professor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfessor not implemented")
+}
+func (UnimplementedSchoolServer) DeleteProfessor(context.Context, *DeleteProfessorRequest) (*DeleteProfessorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfessor not implemented")
+}
+func (UnimplementedSchoolServer) ListProfessors(context.Context, *ListProfessorsRequest) (*ListProfessorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfessors not implemented")
+}
+func (UnimplementedSchoolServer) StreamProfessors(*StreamProfessorsRequest, School_StreamProfessorsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProfessors not implemented")
+}
+func (UnimplementedSchoolServer) CreateStudent(context.Context, *CreateStudentRequest) (*Student, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStudent not implemented")
+}
+func (UnimplementedSchoolServer) GetStudent(context.Context, *GetStudentRequest) (*Student, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudent not implemented")
+}
+func (UnimplementedSchoolServer) UpdateStudent(context.Context, *UpdateStudentRequest) (*Student, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStudent not implemented")
+}
+func (UnimplementedSchoolServer) DeleteStudent(context.Context, *DeleteStudentRequest) (*DeleteStudentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStudent not implemented")
+}
+func (UnimplementedSchoolServer) ListStudents(context.Context, *ListStudentsRequest) (*ListStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudents not implemented")
+}
+func (UnimplementedSchoolServer) StreamStudents(*StreamStudentsRequest, School_StreamStudentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamStudents not implemented")
+}
+func (UnimplementedSchoolServer) mustEmbedUnimplementedSchoolServer() {}
+
+// UnsafeSchoolServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SchoolServer will
+// result in compilation errors.
+type UnsafeSchoolServer interface {
+	mustEmbedUnimplementedSchoolServer()
+}
+
+func RegisterSchoolServer(s grpc.ServiceRegistrar, srv SchoolServer) {
+	s.RegisterService(&School_ServiceDesc, srv)
+}
+
+func _School_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).CreateCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_CreateCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).CreateCourse(ctx, req.(*CreateCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_GetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).GetCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_GetCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).GetCourse(ctx, req.(*GetCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_UpdateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).UpdateCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_UpdateCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).UpdateCourse(ctx, req.(*UpdateCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_DeleteCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).DeleteCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_DeleteCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).DeleteCourse(ctx, req.(*DeleteCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_ListCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).ListCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_ListCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).ListCourses(ctx, req.(*ListCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_StreamCourses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamCoursesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SchoolServer).StreamCourses(m, &schoolStreamCoursesServer{ServerStream: stream})
+}
+
+type School_StreamCoursesServer interface {
+	Send(*Course) error
+	grpc.ServerStream
+}
+
+type schoolStreamCoursesServer struct {
+	grpc.ServerStream
+}
+
+func (x *schoolStreamCoursesServer) Send(m *Course) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _School_CreateProfessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProfessorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).CreateProfessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_CreateProfessor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).CreateProfessor(ctx, req.(*CreateProfessorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_GetProfessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfessorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).GetProfessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_GetProfessor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).GetProfessor(ctx, req.(*GetProfessorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_UpdateProfessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfessorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).UpdateProfessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_UpdateProfessor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).UpdateProfessor(ctx, req.(*UpdateProfessorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_DeleteProfessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProfessorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).DeleteProfessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_DeleteProfessor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).DeleteProfessor(ctx, req.(*DeleteProfessorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_ListProfessors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfessorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).ListProfessors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_ListProfessors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).ListProfessors(ctx, req.(*ListProfessorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_StreamProfessors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamProfessorsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SchoolServer).StreamProfessors(m, &schoolStreamProfessorsServer{ServerStream: stream})
+}
+
+type School_StreamProfessorsServer interface {
+	Send(*Professor) error
+	grpc.ServerStream
+}
+
+type schoolStreamProfessorsServer struct {
+	grpc.ServerStream
+}
+
+func (x *schoolStreamProfessorsServer) Send(m *Professor) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _School_CreateStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).CreateStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_CreateStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).CreateStudent(ctx, req.(*CreateStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_GetStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).GetStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_GetStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).GetStudent(ctx, req.(*GetStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_UpdateStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).UpdateStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_UpdateStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).UpdateStudent(ctx, req.(*UpdateStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_DeleteStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStudentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).DeleteStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_DeleteStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).DeleteStudent(ctx, req.(*DeleteStudentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_ListStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchoolServer).ListStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: School_ListStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchoolServer).ListStudents(ctx, req.(*ListStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _School_StreamStudents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamStudentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SchoolServer).StreamStudents(m, &schoolStreamStudentsServer{ServerStream: stream})
+}
+
+type School_StreamStudentsServer interface {
+	Send(*Student) error
+	grpc.ServerStream
+}
+
+type schoolStreamStudentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *schoolStreamStudentsServer) Send(m *Student) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// School_ServiceDesc is the grpc.ServiceDesc for School service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var School_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "school.v1.School",
+	HandlerType: (*SchoolServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCourse",
+			Handler:    _School_CreateCourse_Handler,
+		},
+		{
+			MethodName: "GetCourse",
+			Handler:    _School_GetCourse_Handler,
+		},
+		{
+			MethodName: "UpdateCourse",
+			Handler:    _School_UpdateCourse_Handler,
+		},
+		{
+			MethodName: "DeleteCourse",
+			Handler:    _School_DeleteCourse_Handler,
+		},
+		{
+			MethodName: "ListCourses",
+			Handler:    _School_ListCourses_Handler,
+		},
+		{
+			MethodName: "CreateProfessor",
+			Handler:    _School_CreateProfessor_Handler,
+		},
+		{
+			MethodName: "GetProfessor",
+			Handler:    _School_GetProfessor_Handler,
+		},
+		{
+			MethodName: "UpdateProfessor",
+			Handler:    _School_UpdateProfessor_Handler,
+		},
+		{
+			MethodName: "DeleteProfessor",
+			Handler:    _School_DeleteProfessor_Handler,
+		},
+		{
+			MethodName: "ListProfessors",
+			Handler:    _School_ListProfessors_Handler,
+		},
+		{
+			MethodName: "CreateStudent",
+			Handler:    _School_CreateStudent_Handler,
+		},
+		{
+			MethodName: "GetStudent",
+			Handler:    _School_GetStudent_Handler,
+		},
+		{
+			MethodName: "UpdateStudent",
+			Handler:    _School_UpdateStudent_Handler,
+		},
+		{
+			MethodName: "DeleteStudent",
+			Handler:    _School_DeleteStudent_Handler,
+		},
+		{
+			MethodName: "ListStudents",
+			Handler:    _School_ListStudents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamCourses",
+			Handler:       _School_StreamCourses_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamProfessors",
+			Handler:       _School_StreamProfessors_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamStudents",
+			Handler:       _School_StreamStudents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "school.proto",
+}
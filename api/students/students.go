@@ -1,21 +1,47 @@
 package students
 
 import (
-	"fmt"
-	"io"
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/validation"
 )
 
 type Student struct {
-	ID              string
-	Name            string
-	Age             uint8
-	Address         string
-	Phone           uint
-	IfInternational bool
-	IfOnProbation   bool
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Age             uint8  `json:"age"`
+	Address         string `json:"address"`
+	Phone           uint   `json:"phone"`
+	IfInternational bool   `json:"if_international"`
+	IfOnProbation   bool   `json:"if_on_probation"`
 }
 
-func NewStudent(r io.Reader, w io.Writer) error {
-	fmt.Fprintln(w, "New student created.")
-	return nil
+// Filter narrows a list of students, the zero value matches every student.
+// Zero ages and nil fields are not applied.
+type Filter struct {
+	NamePrefix      string
+	MinAge          uint8
+	MaxAge          uint8
+	IfInternational *bool
+	IfOnProbation   *bool
+}
+
+// Cursor returns the position right after the student in a list sorted by sort.
+func (student *Student) Cursor(sort string) *pagination.Cursor {
+	switch sort {
+	case pagination.SORT_AGE:
+		return pagination.AgeCursor(student.Age, student.ID)
+	default:
+		return pagination.NameCursor(student.Name, student.ID)
+	}
+}
+
+// Validate reports every field of the student breaking its rule as validation.Errors.
+func (student *Student) Validate() error {
+	errs := validation.Errors{}
+	errs.Add("id", validation.ID(student.ID))
+	errs.Add("name", validation.PersonName(student.Name))
+	errs.Add("age", validation.Age(student.Age))
+	errs.Add("address", validation.Address(student.Address))
+	errs.Add("phone", validation.Phone(student.Phone))
+	return errs.Err()
 }
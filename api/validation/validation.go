@@ -0,0 +1,130 @@
+// Package validation holds the rules a course, professor or student must follow
+// to be stored, whichever front end built it.
+package validation
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strings"
+)
+
+const (
+	MAX_ADDRESS_LENGTH = 255
+
+	MIN_PHONE uint = 1_000_000           // 7 digits
+	MAX_PHONE uint = 999_999_999_999_999 // 15 digits
+)
+
+var (
+	ErrInvalidID      = errors.New("invalid id")
+	ErrInvalidName    = errors.New("invalid name")
+	ErrInvalidAge     = errors.New("invalid age, expected a number from 1 to 255")
+	ErrInvalidAddress = errors.New("invalid address")
+	ErrInvalidPhone   = errors.New("invalid phone number, expected 7 to 15 digits")
+	ErrInvalidSalary  = errors.New("invalid salary, expected a non-negative number")
+)
+
+var (
+	courseNamePattern = regexp.MustCompile(`^\b[ A-Z0-9]+\b$`)
+	personNamePattern = regexp.MustCompile(`^[A-Z][A-Z '\-]*[A-Z]$`)
+)
+
+// FieldError is a field of an object breaking its rule, Err being one of the errors above.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Errors are the fields of an object breaking their rules, in the order they were checked.
+type Errors []*FieldError
+
+func (errs Errors) Error() string {
+	messages := make([]string, len(errs))
+	for i, e := range errs {
+		messages[i] = e.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (errs Errors) Unwrap() []error {
+	unwrapped := make([]error, len(errs))
+	for i, e := range errs {
+		unwrapped[i] = e
+	}
+	return unwrapped
+}
+
+// Add records err against field, nil errors are skipped.
+func (errs *Errors) Add(field string, err error) {
+	if err != nil {
+		*errs = append(*errs, &FieldError{Field: field, Err: err})
+	}
+}
+
+// Err returns errs, or nil when no field broke its rule.
+func (errs Errors) Err() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func ID(id string) error {
+	if strings.TrimSpace(id) == "" {
+		return ErrInvalidID
+	}
+	return nil
+}
+
+// CourseName accepts upper case letters, digits and inner spaces.
+func CourseName(name string) error {
+	if !(courseNamePattern.MatchString(name)) {
+		return ErrInvalidName
+	}
+	return nil
+}
+
+// PersonName accepts upper case letters with inner spaces, apostrophes and dashes.
+func PersonName(name string) error {
+	if !(personNamePattern.MatchString(name)) {
+		return ErrInvalidName
+	}
+	return nil
+}
+
+func Age(age uint8) error {
+	if age == 0 {
+		return ErrInvalidAge
+	}
+	return nil
+}
+
+func Address(address string) error {
+	if strings.TrimSpace(address) == "" || len(address) > MAX_ADDRESS_LENGTH {
+		return ErrInvalidAddress
+	}
+	return nil
+}
+
+func Phone(phone uint) error {
+	if phone < MIN_PHONE || phone > MAX_PHONE {
+		return ErrInvalidPhone
+	}
+	return nil
+}
+
+func Salary(salary float64) error {
+	if !(salary >= 0) || math.IsInf(salary, 1) {
+		return ErrInvalidSalary
+	}
+	return nil
+}
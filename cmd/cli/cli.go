@@ -3,28 +3,75 @@ package cli
 import (
 	"errors"
 	"io"
+	"os"
 
+	"github.com/xHappyface/school/api/ports"
 	"github.com/xHappyface/school/logger"
+	schoolcli "github.com/xHappyface/school/pkg/cli"
 )
 
 var (
-	wrnExtraStatementsTruncated = errors.New("extra statement(s) truncated")
+	ErrScriptFailed = errors.New("script failed")
 
-	errExitSignal     = errors.New("exit signal")
-	errTooFewArgs     = errors.New("too few args")
-	errInvalidCommand = errors.New("invalid command")
+	wrnStatementCancelled    = errors.New("statement cancelled")
+	wrnTransactionRolledBack = errors.New("open transaction rolled back")
+
+	errExitSignal      = errors.New("exit signal")
+	errTooFewArgs      = errors.New("too few args")
+	errInvalidCommand  = errors.New("invalid command")
+	errSchemaOutdated  = errors.New("database schema is out of date, run `migrate up;` first")
+	errTransactionOpen = errors.New("a transaction is open, run `commit;` or `rollback;` first")
+	errNoTransaction   = errors.New("no transaction is open, run `begin;` first")
+	errInvalidMeta     = errors.New("invalid meta-command, expected \\format [table|json|csv]")
 )
 
 type CLIRepository struct {
 	Reader io.Reader
 	Writer io.Writer
 	Logger *logger.SchoolLogger
+	// Script runs the statements read from Reader without prompts or greetings,
+	// stopping at the first one that fails unless ContinueOnError is set.
+	Script          bool
+	ContinueOnError bool
+	// Format is the output format of list and show, see SetFormat.
+	Format string
+	// Messages receives prompts and confirmations instead of Writer when Format
+	// is json or csv, so the output stays machine-readable.
+	Messages io.Writer
+
+	// log is Logger with the id of the running session.
+	log            *logger.SchoolLogger
+	schemaOutdated bool
+	lines          *lineReader
+	failed         bool
+	// uow is the transaction opened by `begin;`, nil while every statement autocommits.
+	uow *ports.UnitOfWork
 }
 
 func NewCLIRepository(r io.Reader, w io.Writer, l *logger.SchoolLogger) *CLIRepository {
 	return &CLIRepository{
-		Reader: r,
-		Writer: w,
-		Logger: l,
+		Reader:   r,
+		Writer:   w,
+		Logger:   l,
+		Format:   schoolcli.FORMAT_TABLE,
+		Messages: os.Stderr,
+	}
+}
+
+// SetFormat sets the output format of list and show to table, json or csv.
+func (cl *CLIRepository) SetFormat(format string) error {
+	format, err := schoolcli.ParseFormat(format)
+	if err != nil {
+		return err
+	}
+	cl.Format = format
+	return nil
+}
+
+// messages is where prompts and confirmations go in the current format.
+func (cl *CLIRepository) messages() io.Writer {
+	if cl.Format == schoolcli.FORMAT_TABLE {
+		return cl.Writer
 	}
+	return cl.Messages
 }
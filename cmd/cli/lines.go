@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"bufio"
+	"io"
+)
+
+// lineReader hands out its input at most one line per Read, so the scanners
+// the statement loop and the prompts each build over it never read ahead
+// into input that belongs to the other.
+type lineReader struct {
+	r *bufio.Reader
+	// line is the number of the line last read from, counting from 1.
+	line        int
+	atLineStart bool
+}
+
+func newLineReader(r io.Reader) *lineReader {
+	return &lineReader{r: bufio.NewReader(r), atLineStart: true}
+}
+
+func (lr *lineReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		b, err := lr.r.ReadByte()
+		if err != nil {
+			if n > 0 {
+				return n, nil
+			}
+			return 0, err
+		}
+		if lr.atLineStart {
+			lr.line++
+			lr.atLineStart = false
+		}
+		p[n] = b
+		n++
+		if b == '\n' {
+			lr.atLineStart = true
+			break
+		}
+	}
+	return n, nil
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// isMeta reports whether line is a meta-command: a line starting with `\`
+// that changes a setting of the session and, unlike a statement, takes no `;`.
+func isMeta(line string) bool {
+	return strings.HasPrefix(strings.TrimSpace(line), `\`)
+}
+
+// meta runs a meta-command, `\format` with no argument shows the current format.
+func (cl *CLIRepository) meta(line string) error {
+	args := strings.Fields(line)
+	switch strings.ToLower(args[0]) {
+	case `\format`:
+		if len(args) == 1 {
+			fmt.Fprintf(cl.messages(), "Output format is %s.\n", cl.Format)
+			return nil
+		}
+		if len(args) > 2 {
+			return errInvalidMeta
+		}
+		if err := cl.SetFormat(args[1]); err != nil {
+			return err
+		}
+		cl.say(fmt.Sprintf("Output format is %s.", cl.Format))
+		return nil
+	default:
+		return errInvalidMeta
+	}
+}
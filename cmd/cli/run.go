@@ -2,60 +2,141 @@ package cli
 
 import (
 	"bufio"
-	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"os"
+	"os/signal"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/xHappyface/school/api/ports"
 	"github.com/xHappyface/school/core/handlers"
 	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/mysql_db"
+	"github.com/xHappyface/school/pkg/parser"
 )
 
+// Run reads statements from cl.Reader until `exit;` or the end of the input.
+// A failing statement is reported and the session goes on, except in script
+// mode where it ends the run with ErrScriptFailed unless ContinueOnError is
+// set, then the run only fails once the script is done.
 func (cl *CLIRepository) Run(sch *ports.SchoolService) error {
-	fmt.Fprintln(cl.Writer, "Welcome.")
-	scanner := bufio.NewScanner(cl.Reader)
-	var input bytes.Buffer
+	cl.log = cl.Logger.With(logger.SESSION_ID_KEY, uuid.NewString())
+	cl.say("Welcome.")
+	if err := cl.checkSchema(context.Background(), sch); err != nil {
+		return err
+	}
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+	defer cl.rollbackOnExit()
+	cl.lines = newLineReader(cl.Reader)
+	cl.failed = false
+	scanner := bufio.NewScanner(cl.lines)
+	p := parser.NewParser()
 	for {
-		fmt.Fprint(cl.Writer, "> ")
-		scanner.Scan()
-		if err := scanner.Err(); err != nil {
-			return err
+		cl.prompt(p.Pending())
+		if !(scanner.Scan()) {
+			if err := scanner.Err(); err != nil {
+				return err
+			}
+			// end of input ends the session like `exit;`
+			if err := p.Close(); err != nil {
+				cl.fail(context.Background(), 0, err)
+			}
+			return cl.exit()
 		}
-		text := strings.ToLower(scanner.Text())
-		if !(strings.ContainsRune(text, ';')) {
-			input.WriteString(text + " ")
+		p.SetLine(cl.lines.line)
+		if !(p.Pending()) && isMeta(scanner.Text()) {
+			if err := cl.meta(scanner.Text()); err != nil && cl.fail(context.Background(), cl.lines.line, err) {
+				return cl.exit()
+			}
 			continue
 		}
-		statements := strings.Split(text, ";")
-		text = strings.ToLower(statements[0])
-		input.WriteString(text)
-		if len(strings.Fields(statements[1])) != 0 {
-			cl.Logger.Log(logger.LOG_LEVEL_WRN, wrnExtraStatementsTruncated.Error())
+		statements, parseErr := p.Parse(scanner.Text())
+		for _, stmt := range statements {
+			// every entry logged while the statement runs carries its correlation id
+			ctx := logger.WithCorrelationID(context.Background(), uuid.NewString())
+			err := cl.executeInterruptible(ctx, interrupts, sch, stmt.Args())
+			if errors.Is(err, errExitSignal) {
+				return cl.exit()
+			} else if err != nil && cl.fail(ctx, stmt.Pos.Line, err) {
+				return cl.exit()
+			}
 		}
-		args := strings.Fields(input.String())
-		input.Reset()
-		if !(len(args) > 0) {
-			cl.Logger.Log(logger.LOG_LEVEL_ERR, errTooFewArgs.Error())
-			continue
-		}
-		if err := cl.execute(sch, args); err != nil && !errors.Is(err, errExitSignal) {
-			return err
-		} else if errors.Is(err, errExitSignal) {
-			fmt.Fprintln(cl.Writer, "Goodbye!")
-			break
+		if parseErr != nil && cl.fail(context.Background(), 0, parseErr) {
+			return cl.exit()
 		}
 	}
+}
+
+// exit ends the session, failing it if a statement of a script failed.
+func (cl *CLIRepository) exit() error {
+	cl.rollbackOnExit()
+	cl.say("Goodbye!")
+	if cl.Script && cl.failed {
+		return ErrScriptFailed
+	}
 	return nil
 }
 
-func (cl *CLIRepository) execute(sch *ports.SchoolService, args []string) error {
-	if args[0] == "exit" {
+// fail logs the error a statement failed with, under the correlation id of ctx, and reports whether the run stops on it.
+// Scripts name the line the statement starts on, unless line is 0 as for syntax errors that carry their own position.
+func (cl *CLIRepository) fail(ctx context.Context, line int, err error) bool {
+	cl.failed = true
+	if cl.Script && line > 0 {
+		cl.log.LogContext(ctx, logger.LOG_LEVEL_ERR, fmt.Sprintf("line %d: %s", line, err))
+	} else {
+		cl.log.LogContext(ctx, logger.LOG_LEVEL_ERR, err.Error())
+	}
+	return cl.Script && !(cl.ContinueOnError)
+}
+
+// say writes a line meant for a person at the prompt, scripts run without them.
+func (cl *CLIRepository) say(text string) {
+	if !(cl.Script) {
+		fmt.Fprintln(cl.messages(), text)
+	}
+}
+
+// executeInterruptible runs one statement under a context derived from parent that SIGINT cancels,
+// so Ctrl-C aborts the statement's queries without ending the session.
+func (cl *CLIRepository) executeInterruptible(parent context.Context, interrupts <-chan os.Signal, sch *ports.SchoolService, args []string) error {
+	// forget interrupts received while idle at the prompt
+	select {
+	case <-interrupts:
+	default:
+	}
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-interrupts:
+			cl.log.LogContext(ctx, logger.LOG_LEVEL_WRN, wrnStatementCancelled.Error())
+			cancel()
+		case <-done:
+		}
+	}()
+	return cl.execute(ctx, sch, args)
+}
+
+func (cl *CLIRepository) execute(ctx context.Context, sch *ports.SchoolService, args []string) error {
+	// keywords are case insensitive, the values that follow keep their case
+	args[0] = strings.ToLower(args[0])
+	if len(args) > 1 {
+		args[1] = strings.ToLower(args[1])
+	}
+	switch args[0] {
+	case "exit":
 		return errExitSignal
+	case "begin", "commit", "rollback":
+		return cl.transaction(args[0], sch)
 	}
 	if !(len(args) >= 2) {
-		cl.Logger.Log(logger.LOG_LEVEL_ERR, errTooFewArgs.Error())
-		return nil
+		return errTooFewArgs
 	}
 	cmd := args[0]
 	obj := args[1]
@@ -64,15 +145,122 @@ func (cl *CLIRepository) execute(sch *ports.SchoolService, args []string) error
 	} else {
 		args = []string{}
 	}
-	handler := handlers.NewSchoolHandler(cl.Reader, cl.Writer, sch, obj, args)
-	var err error
+	if cl.schemaOutdated && cmd != "migrate" {
+		return errSchemaOutdated
+	}
+	if cl.uow != nil {
+		if cmd == "migrate" {
+			return errTransactionOpen
+		}
+		sch = cl.uow.SchoolService
+	}
+	handler := handlers.NewSchoolHandler(ctx, cl.lines, cl.Writer, sch, obj, args, handlers.Options{
+		Interactive: !(cl.Script),
+		Format:      cl.Format,
+		Messages:    cl.messages(),
+	})
 	switch cmd {
 	case "new":
-		if err = handler.HandleCmdNew(); err != nil {
-			cl.Logger.Log(logger.LOG_LEVEL_ERR, err.Error())
+		return handler.HandleCmdNew()
+	case "list":
+		return handler.HandleCmdList()
+	case "show":
+		return handler.HandleCmdShow()
+	case "update":
+		return handler.HandleCmdUpdate()
+	case "delete":
+		return handler.HandleCmdDelete()
+	case "enroll":
+		return handler.HandleCmdEnroll()
+	case "drop":
+		return handler.HandleCmdDrop()
+	case "assign":
+		return handler.HandleCmdAssign()
+	case "unassign":
+		return handler.HandleCmdUnassign()
+	case "migrate":
+		err := handler.HandleCmdMigrate()
+		if schemaErr := cl.checkSchema(ctx, sch); schemaErr != nil {
+			return errors.Join(err, schemaErr)
 		}
+		return err
 	default:
-		cl.Logger.Log(logger.LOG_LEVEL_ERR, errInvalidCommand.Error())
+		return errInvalidCommand
+	}
+}
+
+// checkSchema refuses every command but `migrate` while the database schema is behind the embedded migrations.
+func (cl *CLIRepository) checkSchema(ctx context.Context, sch *ports.SchoolService) error {
+	err := handlers.CheckSchema(ctx, sch)
+	cl.schemaOutdated = errors.Is(err, mysql_db.ErrSchemaOutOfDate)
+	if cl.schemaOutdated {
+		cl.log.LogContext(ctx, logger.LOG_LEVEL_WRN, errSchemaOutdated.Error())
+		return nil
+	}
+	return err
+}
+
+// prompt marks an open transaction with a `*`, and a statement continued from the previous line with a `-`.
+func (cl *CLIRepository) prompt(pending bool) {
+	if cl.Script {
+		return
+	}
+	prompt := "> "
+	if pending {
+		prompt = "-> "
+	}
+	if cl.uow != nil {
+		prompt = "*" + prompt
+	}
+	fmt.Fprint(cl.messages(), prompt)
+}
+
+// transaction runs a `begin;`, `commit;` or `rollback;` statement.
+// Between begin and commit every statement runs in the one unit of work.
+func (cl *CLIRepository) transaction(cmd string, sch *ports.SchoolService) error {
+	if cmd == "begin" {
+		if cl.uow != nil {
+			return errTransactionOpen
+		}
+		if cl.schemaOutdated {
+			return errSchemaOutdated
+		}
+		// the transaction outlives the statement, so it must not end with the statement's context
+		uow, err := sch.Begin(context.Background())
+		if err != nil {
+			return err
+		}
+		cl.uow = uow
+		cl.say("Transaction started.")
+		return nil
+	}
+	if cl.uow == nil {
+		return errNoTransaction
 	}
+	uow := cl.uow
+	cl.uow = nil
+	if cmd == "commit" {
+		if err := uow.Commit(); err != nil {
+			return err
+		}
+		cl.say("Transaction committed.")
+		return nil
+	}
+	if err := uow.Rollback(); err != nil {
+		return err
+	}
+	cl.say("Transaction rolled back.")
 	return nil
 }
+
+// rollbackOnExit discards a transaction left open when the session ends.
+func (cl *CLIRepository) rollbackOnExit() {
+	if cl.uow == nil {
+		return
+	}
+	if err := cl.uow.Rollback(); err != nil {
+		cl.log.Log(logger.LOG_LEVEL_ERR, err.Error())
+	}
+	cl.uow = nil
+	cl.log.Log(logger.LOG_LEVEL_WRN, wrnTransactionRolledBack.Error())
+}
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/logger"
+	schoolcli "github.com/xHappyface/school/pkg/cli"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+const TEST_MILLISECONDS uint = 10_000
+
+// testSession is a memory backend whose log goes to a file the test reads back.
+type testSession struct {
+	sch     *ports.SchoolService
+	log     *logger.SchoolLogger
+	logPath string
+}
+
+func newTestSession(t *testing.T) *testSession {
+	t.Helper()
+	s := &testSession{log: logger.New(), logPath: filepath.Join(t.TempDir(), "school.log")}
+	err := s.log.Configure(&logger.Config{Level: logger.LOG_LEVEL_WRN, Format: logger.FORMAT_TEXT, Sinks: []string{s.logPath}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.log.Close() })
+	if s.sch, err = ports.NewSchoolService(s.log, ports.BACKEND_MEMORY, "", TEST_MILLISECONDS); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.sch.DB.Close() })
+	return s
+}
+
+// scriptRun is what a script wrote to stdout, to the messages writer and to the log.
+type scriptRun struct {
+	stdout   string
+	messages string
+	log      string
+	err      error
+}
+
+// run runs script in script mode, continuing on errors.
+func (s *testSession) run(t *testing.T, script string) *scriptRun {
+	t.Helper()
+	var stdout, messages bytes.Buffer
+	cl := NewCLIRepository(strings.NewReader(script), &stdout, s.log)
+	cl.Messages = &messages
+	cl.Script = true
+	cl.ContinueOnError = true
+	run := &scriptRun{err: cl.Run(s.sch)}
+	log, err := os.ReadFile(s.logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	run.stdout, run.messages, run.log = stdout.String(), messages.String(), string(log)
+	return run
+}
+
+func TestScriptMissingFields(t *testing.T) {
+	s := newTestSession(t)
+	run := s.run(t, "new student name=X;\nnew course name=\"MATH 101\";\n")
+	if !(errors.Is(run.err, ErrScriptFailed)) {
+		t.Errorf("got %v, want %v", run.err, ErrScriptFailed)
+	}
+	want := "line 1: missing field: age, address, phone, international, probation"
+	if !(strings.Contains(run.log, want)) {
+		t.Errorf("log %q lacks %q", run.log, want)
+	}
+	if strings.Contains(run.stdout, "Enter") {
+		t.Errorf("prompted in a script: %q", run.stdout)
+	}
+	ctx := context.Background()
+	if _, err := s.sch.StudentRepo.ReadByName(ctx, "X"); !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+		t.Errorf("student created, got %v", err)
+	}
+	if _, err := s.sch.CourseRepo.ReadByName(ctx, "MATH 101"); err != nil {
+		t.Errorf("the statement after the failing one did not run: %v", err)
+	}
+}
+
+func TestScriptUpdateDelete(t *testing.T) {
+	s := newTestSession(t)
+	ctx := context.Background()
+	course := &courses.Course{ID: "c1", Name: "MATH 101"}
+	if err := schoolcli.CreateCourse(ctx, s.sch, course); err != nil {
+		t.Fatal(err)
+	}
+	script := strings.Join([]string{
+		`update course c1 name="MATH 102";`,
+		`delete course c1;`,
+		`new course name="MATH 103";`,
+		`delete course c1 yes;`,
+	}, "\n")
+	run := s.run(t, script)
+	if !(errors.Is(run.err, ErrScriptFailed)) {
+		t.Errorf("got %v, want %v", run.err, ErrScriptFailed)
+	}
+	if want := "line 2: " + schoolcli.ErrUnconfirmed.Error(); !(strings.Contains(run.log, want)) {
+		t.Errorf("log %q lacks %q", run.log, want)
+	}
+	if strings.Contains(run.stdout, "Enter") || strings.Contains(run.stdout, "Really") {
+		t.Errorf("prompted in a script: %q", run.stdout)
+	}
+	if !(strings.Contains(run.stdout, "Course updated.")) {
+		t.Errorf("course not updated: %q", run.stdout)
+	}
+	if _, err := s.sch.CourseRepo.ReadByID(ctx, "c1"); !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+		t.Errorf("course not deleted, got %v", err)
+	}
+	if _, err := s.sch.CourseRepo.ReadByName(ctx, "MATH 103"); err != nil {
+		t.Errorf("the statement after the unconfirmed delete did not run: %v", err)
+	}
+}
+
+func TestFormatMessages(t *testing.T) {
+	s := newTestSession(t)
+	run := s.run(t, "\\format json\n\\format\nlist courses;\n")
+	if run.err != nil {
+		t.Fatal(run.err)
+	}
+	if want := "[]\n"; run.stdout != want {
+		t.Errorf("got stdout %q, want %q", run.stdout, want)
+	}
+	if want := "Output format is json.\n"; run.messages != want {
+		t.Errorf("got messages %q, want %q", run.messages, want)
+	}
+}
@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/pkg/cli"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+func (srv *Server) listCourses(w http.ResponseWriter, r *http.Request) {
+	filter, page, err := cli.CoursesQuery(queryArgs(r))
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	page.Cursor = r.URL.Query().Get("cursor")
+	list, next, err := srv.sch.CourseRepo.List(r.Context(), filter, page)
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	srv.writeJSON(w, r, http.StatusOK, &listResponse{Items: list, NextCursor: next})
+}
+
+func (srv *Server) createCourse(w http.ResponseWriter, r *http.Request) {
+	course := new(courses.Course)
+	if err := decodeBody(r, course); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	course.ID = uuid.NewString()
+	if err := cli.CheckCourse(course); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	if err := cli.CreateCourse(r.Context(), srv.sch, course); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", "/courses/"+course.ID)
+	srv.writeJSON(w, r, http.StatusCreated, course)
+}
+
+func (srv *Server) readCourse(w http.ResponseWriter, r *http.Request, id string) {
+	course, err := srv.sch.CourseRepo.ReadByID(r.Context(), id)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		err = cli.ErrCourseNotFound
+	}
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	srv.writeJSON(w, r, http.StatusOK, course)
+}
+
+func (srv *Server) replaceCourse(w http.ResponseWriter, r *http.Request, id string) {
+	course := new(courses.Course)
+	if err := decodeBody(r, course); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	course.ID = id
+	if err := cli.CheckCourse(course); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	if err := cli.SaveCourse(r.Context(), srv.sch, course); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	srv.writeJSON(w, r, http.StatusOK, course)
+}
+
+func (srv *Server) deleteCourse(w http.ResponseWriter, r *http.Request, id string) {
+	err := srv.sch.CourseRepo.DeleteByID(r.Context(), id)
+	if errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		err = cli.ErrCourseNotFound
+	}
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,277 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/xHappyface/school/api/pagination"
+)
+
+const (
+	OPENAPI_VERSION = "3.0.3"
+	API_VERSION     = "1.0.0"
+	SPEC_PATH       = "/openapi.json"
+)
+
+var errUndocumentedParameter = errors.New("list parameter missing from the openapi document")
+
+// document is the OpenAPI description of the server. It is generated from the
+// routes as they are registered and from the json fields of the domain structs,
+// so the two cannot drift apart, and a list parameter the cli accepts that it
+// has no schema for fails NewServer.
+type document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       info                             `json:"info"`
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components components                       `json:"components"`
+}
+
+type info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type components struct {
+	Schemas map[string]*schema `json:"schemas"`
+}
+
+type operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Parameters  []*parameter         `json:"parameters,omitempty"`
+	RequestBody *requestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *schema `json:"schema"`
+}
+
+type requestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*mediaType `json:"content"`
+}
+
+type response struct {
+	Description string                `json:"description"`
+	Content     map[string]*mediaType `json:"content,omitempty"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+type schema struct {
+	Ref        string             `json:"$ref,omitempty"`
+	Type       string             `json:"type,omitempty"`
+	Format     string             `json:"format,omitempty"`
+	Enum       []string           `json:"enum,omitempty"`
+	Minimum    *float64           `json:"minimum,omitempty"`
+	Maximum    *float64           `json:"maximum,omitempty"`
+	ReadOnly   bool               `json:"readOnly,omitempty"`
+	Items      *schema            `json:"items,omitempty"`
+	Properties map[string]*schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+}
+
+func newDocument() *document {
+	return &document{
+		OpenAPI: OPENAPI_VERSION,
+		Info:    info{Title: "school", Version: API_VERSION},
+		Paths:   make(map[string]map[string]*operation),
+		Components: components{Schemas: map[string]*schema{
+			"Error": {
+				Type: "object",
+				Properties: map[string]*schema{
+					"error": {Type: "string"},
+					"fields": {Type: "array", Items: &schema{
+						Type:       "object",
+						Properties: map[string]*schema{"field": {Type: "string"}, "error": {Type: "string"}},
+						Required:   []string{"field", "error"},
+					}},
+				},
+				Required: []string{"error"},
+			},
+		}},
+	}
+}
+
+func ref(name string) *schema {
+	return &schema{Ref: "#/components/schemas/" + name}
+}
+
+func bound(f float64) *float64 {
+	return &f
+}
+
+// schemaOf describes the json encoding of the struct v points to.
+// The id is assigned by the server, every other field but booleans is required.
+func schemaOf(v any) *schema {
+	t := reflect.TypeOf(v).Elem()
+	s := &schema{Type: "object", Properties: make(map[string]*schema)}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		var property *schema
+		switch field.Type.Kind() {
+		case reflect.String:
+			property = &schema{Type: "string"}
+		case reflect.Bool:
+			property = &schema{Type: "boolean"}
+		case reflect.Float32, reflect.Float64:
+			property = &schema{Type: "number", Minimum: bound(0)}
+		case reflect.Uint8:
+			property = &schema{Type: "integer", Minimum: bound(1), Maximum: bound(255)}
+		default:
+			property = &schema{Type: "integer", Minimum: bound(0)}
+		}
+		if name == "id" {
+			property.Format = "uuid"
+			property.ReadOnly = true
+		} else if property.Type != "boolean" {
+			s.Required = append(s.Required, name)
+		}
+		s.Properties[name] = property
+	}
+	return s
+}
+
+// listParameter describes the list query parameter named field, sorts being the sort keys the list takes.
+func listParameter(field string, sorts []string) (*parameter, error) {
+	p := &parameter{Name: field, In: "query"}
+	yesNo := &schema{Type: "string", Enum: []string{"y", "n", "yes", "no", "true", "false"}}
+	switch field {
+	case "name":
+		p.Description, p.Schema = "name prefix", &schema{Type: "string"}
+	case "sort":
+		p.Description, p.Schema = "sort key", &schema{Type: "string", Enum: sorts}
+	case "limit":
+		p.Description, p.Schema = "page size", &schema{Type: "integer", Minimum: bound(1), Maximum: bound(float64(pagination.MAX_LIMIT))}
+	case "min_age", "max_age":
+		p.Schema = &schema{Type: "integer", Minimum: bound(1), Maximum: bound(255)}
+	case "min_salary", "max_salary":
+		p.Schema = &schema{Type: "number", Minimum: bound(0)}
+	case "bonus", "international", "probation":
+		p.Schema = yesNo
+	default:
+		return new(parameter), fmt.Errorf("%w: %s", errUndocumentedParameter, field)
+	}
+	return p, nil
+}
+
+// describe adds the operations of res to the document.
+func (doc *document) describe(res *resource) error {
+	prefix, name := res.prefix, res.name
+	plural := strings.TrimPrefix(prefix, "/")
+	doc.Components.Schemas[name] = schemaOf(res.object)
+	doc.Components.Schemas[name+"List"] = &schema{
+		Type: "object",
+		Properties: map[string]*schema{
+			"items":       {Type: "array", Items: ref(name)},
+			"next_cursor": {Type: "string"},
+		},
+		Required: []string{"items", "next_cursor"},
+	}
+	body := &requestBody{Required: true, Content: map[string]*mediaType{"application/json": {Schema: ref(name)}}}
+	object := func(description string) *response {
+		return &response{Description: description, Content: map[string]*mediaType{"application/json": {Schema: ref(name)}}}
+	}
+	failure := func(description string) *response {
+		return &response{Description: description, Content: map[string]*mediaType{"application/json": {Schema: ref("Error")}}}
+	}
+	list := &operation{
+		OperationID: "list" + name + "s",
+		Summary:     "List " + plural,
+		Responses: map[string]*response{
+			"200": {Description: "one page of " + plural, Content: map[string]*mediaType{"application/json": {Schema: ref(name + "List")}}},
+			"400": failure("invalid query parameter"),
+		},
+	}
+	for _, field := range res.listFields {
+		p, err := listParameter(field, res.sorts)
+		if err != nil {
+			return err
+		}
+		list.Parameters = append(list.Parameters, p)
+	}
+	list.Parameters = append(list.Parameters, &parameter{Name: "cursor", In: "query", Description: "next_cursor of the previous page", Schema: &schema{Type: "string"}})
+	doc.Paths[prefix] = map[string]*operation{
+		strings.ToLower(http.MethodGet): list,
+		strings.ToLower(http.MethodPost): {
+			OperationID: "create" + name,
+			Summary:     "Create a " + strings.ToLower(name),
+			RequestBody: body,
+			Responses: map[string]*response{
+				"201": object("created"),
+				"400": failure("invalid body"),
+				"409": failure("name already taken"),
+			},
+		},
+	}
+	id := []*parameter{{Name: "id", In: "path", Required: true, Schema: &schema{Type: "string"}}}
+	doc.Paths[prefix+"/{id}"] = map[string]*operation{
+		strings.ToLower(http.MethodGet): {
+			OperationID: "read" + name,
+			Summary:     "Read a " + strings.ToLower(name),
+			Parameters:  id,
+			Responses: map[string]*response{
+				"200": object("found"),
+				"404": failure("not found"),
+			},
+		},
+		strings.ToLower(http.MethodPut): {
+			OperationID: "replace" + name,
+			Summary:     "Replace a " + strings.ToLower(name),
+			Parameters:  id,
+			RequestBody: body,
+			Responses: map[string]*response{
+				"200": object("replaced"),
+				"400": failure("invalid body"),
+				"404": failure("not found"),
+				"409": failure("name already taken"),
+			},
+		},
+		strings.ToLower(http.MethodDelete): {
+			OperationID: "delete" + name,
+			Summary:     "Delete a " + strings.ToLower(name),
+			Parameters:  id,
+			Responses: map[string]*response{
+				"204": {Description: "deleted"},
+				"404": failure("not found"),
+			},
+		},
+	}
+	return nil
+}
+
+// describeSpec adds the operation serving the document itself.
+func (doc *document) describeSpec() {
+	doc.Paths[SPEC_PATH] = map[string]*operation{
+		strings.ToLower(http.MethodGet): {
+			OperationID: "getOpenAPI",
+			Summary:     "Describe the API",
+			Responses: map[string]*response{
+				"200": {Description: "this document", Content: map[string]*mediaType{"application/json": {Schema: &schema{Type: "object"}}}},
+			},
+		},
+	}
+}
+
+func (srv *Server) serveSpec(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		srv.writeError(w, r, errMethodNotAllowed)
+		return
+	}
+	srv.writeJSON(w, r, http.StatusOK, srv.spec)
+}
@@ -0,0 +1,293 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/logger"
+)
+
+// The openapi tests check the document against the server it describes: every
+// documented operation is sent through the server and must answer with a
+// documented status and a body matching the documented schema.
+
+const TEST_MILLISECONDS uint = 10_000
+
+// fixtures are valid request bodies for each schema, keyed by the purpose
+// they are sent for: seeding an object, creating one, replacing one, and
+// replacing one that does not exist.
+var fixtures = map[string]map[string]any{
+	"Course": {
+		"seed":    &courses.Course{Name: "SEEDED"},
+		"post":    &courses.Course{Name: "POSTED"},
+		"put":     &courses.Course{Name: "REPLACED"},
+		"missing": &courses.Course{Name: "MISSING"},
+	},
+	"Professor": {
+		"seed":    &professors.Professor{Name: "SEEDED PROFESSOR", Age: 40, Address: "1 College Road", Phone: 5550100, Salary: 5000.5},
+		"post":    &professors.Professor{Name: "POSTED PROFESSOR", Age: 41, Address: "2 College Road", Phone: 5550101, Salary: 5000.5},
+		"put":     &professors.Professor{Name: "REPLACED PROFESSOR", Age: 42, Address: "3 College Road", Phone: 5550102, Salary: 5000.5, IfReceivedBonus: true},
+		"missing": &professors.Professor{Name: "MISSING PROFESSOR", Age: 43, Address: "4 College Road", Phone: 5550103, Salary: 5000.5},
+	},
+	"Student": {
+		"seed":    &students.Student{Name: "SEEDED STUDENT", Age: 20, Address: "1 College Road", Phone: 5550200},
+		"post":    &students.Student{Name: "POSTED STUDENT", Age: 21, Address: "2 College Road", Phone: 5550201},
+		"put":     &students.Student{Name: "REPLACED STUDENT", Age: 22, Address: "3 College Road", Phone: 5550202, IfInternational: true},
+		"missing": &students.Student{Name: "MISSING STUDENT", Age: 23, Address: "4 College Road", Phone: 5550203},
+	},
+}
+
+func testServer(t *testing.T) *Server {
+	t.Helper()
+	l := logger.New()
+	err := l.Configure(&logger.Config{Level: logger.LOG_LEVEL_ERR, Format: logger.FORMAT_TEXT, Sinks: []string{logger.SINK_STDERR}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sch, err := ports.NewSchoolService(l, ports.BACKEND_MEMORY, "", TEST_MILLISECONDS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sch.DB.Close() })
+	srv, err := NewServer(sch, l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv
+}
+
+func serve(t *testing.T, srv *Server, method string, target string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := httptest.NewRequest(method, target, &payload)
+	if body != nil {
+		r.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, r)
+	return w
+}
+
+// bodySchema returns the name of the schema the body of op refers to.
+func bodySchema(op *operation) string {
+	return strings.TrimPrefix(op.RequestBody.Content["application/json"].Schema.Ref, "#/components/schemas/")
+}
+
+// checkResponse fails the test unless w answers op with a documented status
+// and, where the response has content, a body matching its schema.
+func checkResponse(t *testing.T, doc *document, op *operation, w *httptest.ResponseRecorder) {
+	t.Helper()
+	res, ok := op.Responses[strconv.Itoa(w.Code)]
+	if !(ok) {
+		t.Fatalf("%s: undocumented status %d: %s", op.OperationID, w.Code, w.Body)
+	}
+	if res.Content == nil {
+		if w.Body.Len() != 0 {
+			t.Errorf("%s: got body %s, want none", op.OperationID, w.Body)
+		}
+		return
+	}
+	if got := w.Header().Get("Content-Type"); !(strings.HasPrefix(got, "application/json")) {
+		t.Errorf("%s: got Content-Type %q, want application/json", op.OperationID, got)
+	}
+	var body any
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatalf("%s: %v: %s", op.OperationID, err, w.Body)
+	}
+	if err := validate(doc, res.Content["application/json"].Schema, body, "body"); err != nil {
+		t.Errorf("%s: %v", op.OperationID, err)
+	}
+}
+
+// validate checks the decoded json v against s, at is where v sits in the body.
+func validate(doc *document, s *schema, v any, at string) error {
+	if s.Ref != "" {
+		name := strings.TrimPrefix(s.Ref, "#/components/schemas/")
+		resolved, ok := doc.Components.Schemas[name]
+		if !(ok) {
+			return fmt.Errorf("%s: undefined schema %s", at, s.Ref)
+		}
+		return validate(doc, resolved, v, at)
+	}
+	switch s.Type {
+	case "object":
+		object, ok := v.(map[string]any)
+		if !(ok) {
+			return fmt.Errorf("%s: got %T, want an object", at, v)
+		}
+		for _, name := range s.Required {
+			if _, ok := object[name]; !(ok) {
+				return fmt.Errorf("%s: missing required %q", at, name)
+			}
+		}
+		if s.Properties == nil {
+			return nil
+		}
+		for name, value := range object {
+			property, ok := s.Properties[name]
+			if !(ok) {
+				return fmt.Errorf("%s: undocumented property %q", at, name)
+			}
+			if err := validate(doc, property, value, at+"."+name); err != nil {
+				return err
+			}
+		}
+	case "array":
+		array, ok := v.([]any)
+		if !(ok) {
+			return fmt.Errorf("%s: got %T, want an array", at, v)
+		}
+		for i, item := range array {
+			if err := validate(doc, s.Items, item, fmt.Sprintf("%s[%d]", at, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		str, ok := v.(string)
+		if !(ok) {
+			return fmt.Errorf("%s: got %T, want a string", at, v)
+		}
+		if s.Format == "uuid" {
+			if _, err := uuid.Parse(str); err != nil {
+				return fmt.Errorf("%s: %w", at, err)
+			}
+		}
+		if len(s.Enum) > 0 && !(contains(s.Enum, str)) {
+			return fmt.Errorf("%s: %q not in %q", at, str, s.Enum)
+		}
+	case "integer", "number":
+		n, ok := v.(float64)
+		if !(ok) {
+			return fmt.Errorf("%s: got %T, want a %s", at, v, s.Type)
+		}
+		if s.Type == "integer" && n != math.Trunc(n) {
+			return fmt.Errorf("%s: got %v, want an integer", at, n)
+		}
+		if (s.Minimum != nil && n < *s.Minimum) || (s.Maximum != nil && n > *s.Maximum) {
+			return fmt.Errorf("%s: %v out of bounds", at, n)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !(ok) {
+			return fmt.Errorf("%s: got %T, want a boolean", at, v)
+		}
+	default:
+		return fmt.Errorf("%s: unknown schema type %q", at, s.Type)
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedMethods returns the methods of ops in the order a scenario needs them:
+// reads first, then creates and replaces, deletes last.
+func sortedMethods(ops map[string]*operation) []string {
+	order := map[string]int{"get": 0, "post": 1, "put": 2, "delete": 3}
+	methods := make([]string, 0, len(ops))
+	for method := range ops {
+		methods = append(methods, method)
+	}
+	sort.Slice(methods, func(i, j int) bool { return order[methods[i]] < order[methods[j]] })
+	return methods
+}
+
+func TestSpecOperations(t *testing.T) {
+	srv := testServer(t)
+	doc := srv.spec
+	paths := make([]string, 0, len(doc.Paths))
+	for path := range doc.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		ops := doc.Paths[path]
+		for _, method := range sortedMethods(ops) {
+			op := ops[method]
+			t.Run(op.OperationID, func(t *testing.T) {
+				target := path
+				if prefix, ok := strings.CutSuffix(path, "/{id}"); ok {
+					create := doc.Paths[prefix]["post"]
+					w := serve(t, srv, http.MethodPost, prefix, fixtures[bodySchema(create)]["seed"])
+					if w.Code != http.StatusCreated {
+						t.Fatalf("seeding %s: got %d: %s", prefix, w.Code, w.Body)
+					}
+					target = w.Header().Get("Location")
+					t.Cleanup(func() { serve(t, srv, http.MethodDelete, target, nil) })
+				}
+				var body any
+				if op.RequestBody != nil {
+					body = fixtures[bodySchema(op)][method]
+				}
+				w := serve(t, srv, strings.ToUpper(method), target, body)
+				checkResponse(t, doc, op, w)
+				if w.Code >= 300 {
+					t.Errorf("got %d, want success: %s", w.Code, w.Body)
+				}
+			})
+		}
+	}
+}
+
+func TestSpecNotFound(t *testing.T) {
+	srv := testServer(t)
+	doc := srv.spec
+	for path, ops := range doc.Paths {
+		if !(strings.HasSuffix(path, "/{id}")) {
+			continue
+		}
+		for method, op := range ops {
+			t.Run(op.OperationID, func(t *testing.T) {
+				var body any
+				if op.RequestBody != nil {
+					body = fixtures[bodySchema(op)]["missing"]
+				}
+				target := strings.Replace(path, "{id}", uuid.NewString(), 1)
+				w := serve(t, srv, strings.ToUpper(method), target, body)
+				checkResponse(t, doc, op, w)
+				if w.Code != http.StatusNotFound {
+					t.Errorf("got %d, want %d", w.Code, http.StatusNotFound)
+				}
+			})
+		}
+	}
+}
+
+func TestSpecRoutes(t *testing.T) {
+	srv := testServer(t)
+	if len(srv.routes) == 0 {
+		t.Fatal("no routes registered")
+	}
+	for _, route := range srv.routes {
+		path := route
+		if strings.HasSuffix(route, "/") {
+			path = route + "{id}"
+		}
+		if _, ok := srv.spec.Paths[path]; !(ok) {
+			t.Errorf("route %s registered but %s missing from the document", route, path)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/pkg/cli"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+func (srv *Server) listProfessors(w http.ResponseWriter, r *http.Request) {
+	filter, page, err := cli.ProfessorsQuery(queryArgs(r))
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	page.Cursor = r.URL.Query().Get("cursor")
+	list, next, err := srv.sch.ProfessorRepo.List(r.Context(), filter, page)
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	srv.writeJSON(w, r, http.StatusOK, &listResponse{Items: list, NextCursor: next})
+}
+
+func (srv *Server) createProfessor(w http.ResponseWriter, r *http.Request) {
+	professor := new(professors.Professor)
+	if err := decodeBody(r, professor); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	professor.ID = uuid.NewString()
+	if err := cli.CheckProfessor(professor); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	if err := cli.CreateProfessor(r.Context(), srv.sch, professor); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", "/professors/"+professor.ID)
+	srv.writeJSON(w, r, http.StatusCreated, professor)
+}
+
+func (srv *Server) readProfessor(w http.ResponseWriter, r *http.Request, id string) {
+	professor, err := srv.sch.ProfessorRepo.ReadByID(r.Context(), id)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		err = cli.ErrProfessorNotFound
+	}
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	srv.writeJSON(w, r, http.StatusOK, professor)
+}
+
+func (srv *Server) replaceProfessor(w http.ResponseWriter, r *http.Request, id string) {
+	professor := new(professors.Professor)
+	if err := decodeBody(r, professor); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	professor.ID = id
+	if err := cli.CheckProfessor(professor); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	if err := cli.SaveProfessor(r.Context(), srv.sch, professor); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	srv.writeJSON(w, r, http.StatusOK, professor)
+}
+
+func (srv *Server) deleteProfessor(w http.ResponseWriter, r *http.Request, id string) {
+	err := srv.sch.ProfessorRepo.DeleteByID(r.Context(), id)
+	if errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		err = cli.ErrProfessorNotFound
+	}
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,340 @@
+// Package rest serves the school over HTTP as JSON resources:
+//
+//	GET    /courses        list, query parameters as for `list courses` plus `cursor`
+//	POST   /courses        create
+//	GET    /courses/{id}   read
+//	PUT    /courses/{id}   replace
+//	DELETE /courses/{id}   delete
+//
+// and likewise for /professors and /students. GET /openapi.json describes them all.
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/api/validation"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/cli"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+const (
+	MAX_BODY_BYTES     int64 = 1 << 20
+	CORRELATION_HEADER       = "X-Correlation-ID"
+	MAX_CORRELATION_ID       = 128
+
+	shutdownTimeout = 10 * time.Second
+)
+
+var (
+	errInvalidBody      = errors.New("invalid request body")
+	errNotFound         = errors.New("not found")
+	errMethodNotAllowed = errors.New("method not allowed")
+	errInternal         = errors.New("internal server error")
+
+	// badRequests are the errors caused by what the client sent.
+	badRequests = []error{
+		errInvalidBody,
+		validation.ErrInvalidID,
+		cli.ErrInvalidName,
+		cli.ErrInvalidAge,
+		cli.ErrInvalidAddress,
+		cli.ErrInvalidPhone,
+		cli.ErrInvalidSalary,
+		cli.ErrInvalidYesNo,
+		cli.ErrInvalidField,
+		cli.ErrUnknownField,
+		cli.ErrInvalidLimit,
+		pagination.ErrInvalidCursor,
+		pagination.ErrInvalidSort,
+	}
+	notFounds = []error{
+		errNotFound,
+		mysql_db.ErrZeroRowsRetrieved,
+		mysql_db.ErrZeroRowsAffected,
+		cli.ErrCourseNotFound,
+		cli.ErrProfessorNotFound,
+		cli.ErrStudentNotFound,
+	}
+)
+
+type Server struct {
+	sch    *ports.SchoolService
+	logger *logger.SchoolLogger
+	mux    *http.ServeMux
+	spec   *document
+	// routes are the patterns registered on mux, each described by spec.
+	routes []string
+}
+
+func NewServer(sch *ports.SchoolService, l *logger.SchoolLogger) (*Server, error) {
+	srv := &Server{
+		sch:    sch,
+		logger: l,
+		mux:    http.NewServeMux(),
+		spec:   newDocument(),
+	}
+	resources := []*resource{
+		{
+			prefix:     "/courses",
+			name:       "Course",
+			object:     new(courses.Course),
+			listFields: cli.CourseListFields,
+			sorts:      []string{pagination.SORT_NAME},
+			list:       srv.listCourses,
+			create:     srv.createCourse,
+			read:       srv.readCourse,
+			replace:    srv.replaceCourse,
+			remove:     srv.deleteCourse,
+		},
+		{
+			prefix:     "/professors",
+			name:       "Professor",
+			object:     new(professors.Professor),
+			listFields: cli.ProfessorListFields,
+			sorts:      []string{pagination.SORT_NAME, pagination.SORT_AGE, pagination.SORT_SALARY},
+			list:       srv.listProfessors,
+			create:     srv.createProfessor,
+			read:       srv.readProfessor,
+			replace:    srv.replaceProfessor,
+			remove:     srv.deleteProfessor,
+		},
+		{
+			prefix:     "/students",
+			name:       "Student",
+			object:     new(students.Student),
+			listFields: cli.StudentListFields,
+			sorts:      []string{pagination.SORT_NAME, pagination.SORT_AGE},
+			list:       srv.listStudents,
+			create:     srv.createStudent,
+			read:       srv.readStudent,
+			replace:    srv.replaceStudent,
+			remove:     srv.deleteStudent,
+		},
+	}
+	for _, res := range resources {
+		if err := srv.route(res); err != nil {
+			return new(Server), err
+		}
+	}
+	srv.spec.describeSpec()
+	srv.handle(SPEC_PATH, srv.serveSpec)
+	return srv, nil
+}
+
+func (srv *Server) handle(pattern string, handler http.HandlerFunc) {
+	srv.mux.HandleFunc(pattern, handler)
+	srv.routes = append(srv.routes, pattern)
+}
+
+// ServeHTTP serves r under the correlation id its CORRELATION_HEADER gives, or a new one,
+// which is echoed in the response and carried by every entry logged for the request.
+func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := r.Header.Get(CORRELATION_HEADER)
+	if id == "" || len(id) > MAX_CORRELATION_ID {
+		id = uuid.NewString()
+	}
+	r = r.WithContext(logger.WithCorrelationID(r.Context(), id))
+	w.Header().Set(CORRELATION_HEADER, id)
+	rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	srv.mux.ServeHTTP(rec, r)
+	srv.logger.LogContext(r.Context(), logger.LOG_LEVEL_INFO, "request served", "method", r.Method, "path", r.URL.Path, "status", rec.status)
+}
+
+// ListenAndServe serves on addr until ctx is done, then lets the requests in flight finish.
+func (srv *Server) ListenAndServe(ctx context.Context, addr string) error {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           srv,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.ListenAndServe()
+	}()
+	srv.logger.Log(logger.LOG_LEVEL_INFO, "listening on "+addr)
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errs; !(errors.Is(err, http.ErrServerClosed)) {
+		return err
+	}
+	return nil
+}
+
+type collectionHandler func(w http.ResponseWriter, r *http.Request)
+type itemHandler func(w http.ResponseWriter, r *http.Request, id string)
+
+// resource is one kind of object, served as a collection at prefix with its items below.
+type resource struct {
+	prefix string
+	// name and object, a pointer to a zero value, name and describe the object in the OpenAPI document.
+	name       string
+	object     any
+	listFields []string
+	sorts      []string
+
+	list, create          collectionHandler
+	read, replace, remove itemHandler
+}
+
+// route serves res and describes it in the OpenAPI document.
+func (srv *Server) route(res *resource) error {
+	if err := srv.spec.describe(res); err != nil {
+		return err
+	}
+	prefix, list, create, read, replace, remove := res.prefix, res.list, res.create, res.read, res.replace, res.remove
+	srv.handle(prefix, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			list(w, r)
+		case http.MethodPost:
+			create(w, r)
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			srv.writeError(w, r, errMethodNotAllowed)
+		}
+	})
+	srv.handle(prefix+"/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, prefix+"/")
+		if id == "" || strings.Contains(id, "/") {
+			srv.writeError(w, r, errNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			read(w, r, id)
+		case http.MethodPut:
+			replace(w, r, id)
+		case http.MethodDelete:
+			remove(w, r, id)
+		default:
+			w.Header().Set("Allow", "GET, PUT, DELETE")
+			srv.writeError(w, r, errMethodNotAllowed)
+		}
+	})
+	return nil
+}
+
+// listResponse is one page of a list, NextCursor is empty on the last page.
+type listResponse struct {
+	Items      any    `json:"items"`
+	NextCursor string `json:"next_cursor"`
+}
+
+// errorResponse lists the fields breaking their rules when the body failed validation.
+type errorResponse struct {
+	Error  string        `json:"error"`
+	Fields []*fieldError `json:"fields,omitempty"`
+}
+
+type fieldError struct {
+	Field string `json:"field"`
+	Error string `json:"error"`
+}
+
+// queryArgs turns the query parameters but the cursor into the `key=value` args the cli list commands take.
+func queryArgs(r *http.Request) []string {
+	args := []string{}
+	for key, values := range r.URL.Query() {
+		if key == "cursor" {
+			continue
+		}
+		for _, value := range values {
+			args = append(args, key+"="+value)
+		}
+	}
+	return args
+}
+
+// decodeBody reads the JSON object in the request body into v, rejecting unknown fields.
+func decodeBody(r *http.Request, v any) error {
+	decoder := json.NewDecoder(io.LimitReader(r.Body, MAX_BODY_BYTES))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("%w: %s", errInvalidBody, err)
+	}
+	if decoder.More() {
+		return fmt.Errorf("%w: trailing data after object", errInvalidBody)
+	}
+	return nil
+}
+
+func (srv *Server) writeJSON(w http.ResponseWriter, r *http.Request, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		srv.logger.LogContext(r.Context(), logger.LOG_LEVEL_ERR, err.Error())
+	}
+}
+
+// writeError answers with the status err maps to, hiding the details of server side errors.
+func (srv *Server) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status := statusOf(err)
+	if status == http.StatusInternalServerError {
+		srv.logger.LogContext(r.Context(), logger.LOG_LEVEL_ERR, err.Error())
+		err = errInternal
+	}
+	resp := &errorResponse{Error: err.Error()}
+	var invalid validation.Errors
+	if errors.As(err, &invalid) {
+		for _, e := range invalid {
+			resp.Fields = append(resp.Fields, &fieldError{Field: e.Field, Error: e.Err.Error()})
+		}
+	}
+	srv.writeJSON(w, r, status, resp)
+}
+
+func statusOf(err error) int {
+	for _, target := range badRequests {
+		if errors.Is(err, target) {
+			return http.StatusBadRequest
+		}
+	}
+	for _, target := range notFounds {
+		if errors.Is(err, target) {
+			return http.StatusNotFound
+		}
+	}
+	switch {
+	case errors.Is(err, cli.ErrObjectAlreadyExists):
+		return http.StatusConflict
+	case errors.Is(err, errMethodNotAllowed):
+		return http.StatusMethodNotAllowed
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// statusRecorder remembers the status written, for the request log.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/pkg/cli"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+func (srv *Server) listStudents(w http.ResponseWriter, r *http.Request) {
+	filter, page, err := cli.StudentsQuery(queryArgs(r))
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	page.Cursor = r.URL.Query().Get("cursor")
+	list, next, err := srv.sch.StudentRepo.List(r.Context(), filter, page)
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	srv.writeJSON(w, r, http.StatusOK, &listResponse{Items: list, NextCursor: next})
+}
+
+func (srv *Server) createStudent(w http.ResponseWriter, r *http.Request) {
+	student := new(students.Student)
+	if err := decodeBody(r, student); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	student.ID = uuid.NewString()
+	if err := cli.CheckStudent(student); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	if err := cli.CreateStudent(r.Context(), srv.sch, student); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Location", "/students/"+student.ID)
+	srv.writeJSON(w, r, http.StatusCreated, student)
+}
+
+func (srv *Server) readStudent(w http.ResponseWriter, r *http.Request, id string) {
+	student, err := srv.sch.StudentRepo.ReadByID(r.Context(), id)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		err = cli.ErrStudentNotFound
+	}
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	srv.writeJSON(w, r, http.StatusOK, student)
+}
+
+func (srv *Server) replaceStudent(w http.ResponseWriter, r *http.Request, id string) {
+	student := new(students.Student)
+	if err := decodeBody(r, student); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	student.ID = id
+	if err := cli.CheckStudent(student); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	if err := cli.SaveStudent(r.Context(), srv.sch, student); err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	srv.writeJSON(w, r, http.StatusOK, student)
+}
+
+func (srv *Server) deleteStudent(w http.ResponseWriter, r *http.Request, id string) {
+	err := srv.sch.StudentRepo.DeleteByID(r.Context(), id)
+	if errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		err = cli.ErrStudentNotFound
+	}
+	if err != nil {
+		srv.writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,131 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/schoolpb"
+	"github.com/xHappyface/school/pkg/cli"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+func coursePB(course *courses.Course) *schoolpb.Course {
+	return &schoolpb.Course{
+		Id:   course.ID,
+		Name: course.Name,
+	}
+}
+
+func courseOf(msg *schoolpb.Course) (*courses.Course, error) {
+	if msg == nil {
+		return new(courses.Course), errMissingObject
+	}
+	return &courses.Course{
+		ID:   msg.GetId(),
+		Name: msg.GetName(),
+	}, nil
+}
+
+func courseArgs(filter *schoolpb.CourseFilter) filterArgs {
+	args := filterArgs{}
+	args.text("name", filter.GetNamePrefix())
+	return args
+}
+
+func (srv *Server) CreateCourse(ctx context.Context, req *schoolpb.CreateCourseRequest) (*schoolpb.Course, error) {
+	course, err := courseOf(req.GetCourse())
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	course.ID = uuid.NewString()
+	if err = cli.CheckCourse(course); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	if err = cli.CreateCourse(ctx, srv.sch, course); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return coursePB(course), nil
+}
+
+func (srv *Server) GetCourse(ctx context.Context, req *schoolpb.GetCourseRequest) (*schoolpb.Course, error) {
+	course, err := srv.sch.CourseRepo.ReadByID(ctx, req.GetId())
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		err = cli.ErrCourseNotFound
+	}
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return coursePB(course), nil
+}
+
+func (srv *Server) UpdateCourse(ctx context.Context, req *schoolpb.UpdateCourseRequest) (*schoolpb.Course, error) {
+	course, err := courseOf(req.GetCourse())
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	if err = cli.CheckCourse(course); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	if err = cli.SaveCourse(ctx, srv.sch, course); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return coursePB(course), nil
+}
+
+func (srv *Server) DeleteCourse(ctx context.Context, req *schoolpb.DeleteCourseRequest) (*schoolpb.DeleteCourseResponse, error) {
+	err := srv.sch.CourseRepo.DeleteByID(ctx, req.GetId())
+	if errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		err = cli.ErrCourseNotFound
+	}
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return &schoolpb.DeleteCourseResponse{}, nil
+}
+
+func (srv *Server) ListCourses(ctx context.Context, req *schoolpb.ListCoursesRequest) (*schoolpb.ListCoursesResponse, error) {
+	args := courseArgs(req.GetFilter())
+	args.page(req.GetPage())
+	filter, page, err := cli.CoursesQuery(args)
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	page.Cursor = req.GetPage().GetCursor()
+	list, next, err := srv.sch.CourseRepo.List(ctx, filter, page)
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	resp := &schoolpb.ListCoursesResponse{NextCursor: next}
+	for _, course := range list {
+		resp.Courses = append(resp.Courses, coursePB(course))
+	}
+	return resp, nil
+}
+
+// StreamCourses sends every course matching the filter, one page read at a time.
+func (srv *Server) StreamCourses(req *schoolpb.StreamCoursesRequest, stream schoolpb.School_StreamCoursesServer) error {
+	ctx := stream.Context()
+	args := courseArgs(req.GetFilter())
+	args.text("sort", req.GetSort())
+	filter, page, err := cli.CoursesQuery(args)
+	if err != nil {
+		return srv.statusError(ctx, err)
+	}
+	for {
+		list, next, err := srv.sch.CourseRepo.List(ctx, filter, page)
+		if err != nil {
+			return srv.statusError(ctx, err)
+		}
+		for _, course := range list {
+			if err = stream.Send(coursePB(course)); err != nil {
+				return err
+			}
+		}
+		if next == "" {
+			return nil
+		}
+		page.Cursor = next
+	}
+}
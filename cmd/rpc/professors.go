@@ -0,0 +1,152 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/api/schoolpb"
+	"github.com/xHappyface/school/pkg/cli"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+func professorPB(professor *professors.Professor) *schoolpb.Professor {
+	return &schoolpb.Professor{
+		Id:              professor.ID,
+		Name:            professor.Name,
+		Age:             uint32(professor.Age),
+		Address:         professor.Address,
+		Phone:           uint64(professor.Phone),
+		Salary:          professor.Salary,
+		IfReceivedBonus: professor.IfReceivedBonus,
+	}
+}
+
+func professorOf(msg *schoolpb.Professor) (*professors.Professor, error) {
+	if msg == nil {
+		return new(professors.Professor), errMissingObject
+	}
+	if msg.GetAge() > math.MaxUint8 {
+		return new(professors.Professor), cli.ErrInvalidAge
+	}
+	return &professors.Professor{
+		ID:              msg.GetId(),
+		Name:            msg.GetName(),
+		Age:             uint8(msg.GetAge()),
+		Address:         msg.GetAddress(),
+		Phone:           uint(msg.GetPhone()),
+		Salary:          msg.GetSalary(),
+		IfReceivedBonus: msg.GetIfReceivedBonus(),
+	}, nil
+}
+
+func professorArgs(filter *schoolpb.ProfessorFilter) filterArgs {
+	args := filterArgs{}
+	args.text("name", filter.GetNamePrefix())
+	args.uint("min_age", filter.GetMinAge())
+	args.uint("max_age", filter.GetMaxAge())
+	if filter != nil {
+		args.float("min_salary", filter.MinSalary)
+		args.float("max_salary", filter.MaxSalary)
+		args.yesNo("bonus", filter.IfReceivedBonus)
+	}
+	return args
+}
+
+func (srv *Server) CreateProfessor(ctx context.Context, req *schoolpb.CreateProfessorRequest) (*schoolpb.Professor, error) {
+	professor, err := professorOf(req.GetProfessor())
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	professor.ID = uuid.NewString()
+	if err = cli.CheckProfessor(professor); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	if err = cli.CreateProfessor(ctx, srv.sch, professor); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return professorPB(professor), nil
+}
+
+func (srv *Server) GetProfessor(ctx context.Context, req *schoolpb.GetProfessorRequest) (*schoolpb.Professor, error) {
+	professor, err := srv.sch.ProfessorRepo.ReadByID(ctx, req.GetId())
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		err = cli.ErrProfessorNotFound
+	}
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return professorPB(professor), nil
+}
+
+func (srv *Server) UpdateProfessor(ctx context.Context, req *schoolpb.UpdateProfessorRequest) (*schoolpb.Professor, error) {
+	professor, err := professorOf(req.GetProfessor())
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	if err = cli.CheckProfessor(professor); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	if err = cli.SaveProfessor(ctx, srv.sch, professor); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return professorPB(professor), nil
+}
+
+func (srv *Server) DeleteProfessor(ctx context.Context, req *schoolpb.DeleteProfessorRequest) (*schoolpb.DeleteProfessorResponse, error) {
+	err := srv.sch.ProfessorRepo.DeleteByID(ctx, req.GetId())
+	if errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		err = cli.ErrProfessorNotFound
+	}
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return &schoolpb.DeleteProfessorResponse{}, nil
+}
+
+func (srv *Server) ListProfessors(ctx context.Context, req *schoolpb.ListProfessorsRequest) (*schoolpb.ListProfessorsResponse, error) {
+	args := professorArgs(req.GetFilter())
+	args.page(req.GetPage())
+	filter, page, err := cli.ProfessorsQuery(args)
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	page.Cursor = req.GetPage().GetCursor()
+	list, next, err := srv.sch.ProfessorRepo.List(ctx, filter, page)
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	resp := &schoolpb.ListProfessorsResponse{NextCursor: next}
+	for _, professor := range list {
+		resp.Professors = append(resp.Professors, professorPB(professor))
+	}
+	return resp, nil
+}
+
+// StreamProfessors sends every professor matching the filter, one page read at a time.
+func (srv *Server) StreamProfessors(req *schoolpb.StreamProfessorsRequest, stream schoolpb.School_StreamProfessorsServer) error {
+	ctx := stream.Context()
+	args := professorArgs(req.GetFilter())
+	args.text("sort", req.GetSort())
+	filter, page, err := cli.ProfessorsQuery(args)
+	if err != nil {
+		return srv.statusError(ctx, err)
+	}
+	for {
+		list, next, err := srv.sch.ProfessorRepo.List(ctx, filter, page)
+		if err != nil {
+			return srv.statusError(ctx, err)
+		}
+		for _, professor := range list {
+			if err = stream.Send(professorPB(professor)); err != nil {
+				return err
+			}
+		}
+		if next == "" {
+			return nil
+		}
+		page.Cursor = next
+	}
+}
@@ -0,0 +1,212 @@
+// Package rpc serves the school over gRPC, as described by api/schoolpb/school.proto.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/schoolpb"
+	"github.com/xHappyface/school/api/validation"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/cli"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+const (
+	CORRELATION_METADATA = "x-correlation-id"
+	MAX_CORRELATION_ID   = 128
+)
+
+var (
+	errMissingObject = errors.New("missing object in request")
+
+	// invalidArguments are the errors caused by what the client sent.
+	invalidArguments = []error{
+		errMissingObject,
+		validation.ErrInvalidID,
+		cli.ErrInvalidName,
+		cli.ErrInvalidAge,
+		cli.ErrInvalidAddress,
+		cli.ErrInvalidPhone,
+		cli.ErrInvalidSalary,
+		cli.ErrInvalidYesNo,
+		cli.ErrInvalidField,
+		cli.ErrUnknownField,
+		cli.ErrInvalidLimit,
+		pagination.ErrInvalidCursor,
+		pagination.ErrInvalidSort,
+	}
+	notFounds = []error{
+		mysql_db.ErrZeroRowsRetrieved,
+		mysql_db.ErrZeroRowsAffected,
+		cli.ErrCourseNotFound,
+		cli.ErrProfessorNotFound,
+		cli.ErrStudentNotFound,
+	}
+)
+
+type Server struct {
+	schoolpb.UnimplementedSchoolServer
+
+	sch    *ports.SchoolService
+	logger *logger.SchoolLogger
+}
+
+func NewServer(sch *ports.SchoolService, l *logger.SchoolLogger) *Server {
+	return &Server{
+		sch:    sch,
+		logger: l,
+	}
+}
+
+// ListenAndServe serves on addr until ctx is done, then lets the calls in flight finish.
+func (srv *Server) ListenAndServe(ctx context.Context, addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	server := srv.grpcServer()
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.Serve(listener)
+	}()
+	srv.logger.Log(logger.LOG_LEVEL_INFO, "listening on "+listener.Addr().String())
+	select {
+	case err = <-errs:
+		return err
+	case <-ctx.Done():
+	}
+	server.GracefulStop()
+	return <-errs
+}
+
+// grpcServer returns a gRPC server serving srv through the logging interceptors.
+func (srv *Server) grpcServer() *grpc.Server {
+	server := grpc.NewServer(grpc.UnaryInterceptor(srv.logUnary), grpc.StreamInterceptor(srv.logStream))
+	schoolpb.RegisterSchoolServer(server, srv)
+	return server
+}
+
+// correlate returns ctx carrying the correlation id the CORRELATION_METADATA of the call gives,
+// or a new one, and sends the id back in the response header.
+func correlate(ctx context.Context) context.Context {
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(CORRELATION_METADATA); len(values) > 0 && len(values[0]) <= MAX_CORRELATION_ID {
+			id = values[0]
+		}
+	}
+	if id == "" {
+		id = uuid.NewString()
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(CORRELATION_METADATA, id))
+	return logger.WithCorrelationID(ctx, id)
+}
+
+func (srv *Server) logUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx = correlate(ctx)
+	resp, err := handler(ctx, req)
+	srv.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "call served", "method", info.FullMethod, "code", status.Code(err).String())
+	return resp, err
+}
+
+func (srv *Server) logStream(s any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	correlated := &correlatedStream{ServerStream: stream, ctx: correlate(stream.Context())}
+	err := handler(s, correlated)
+	srv.logger.LogContext(correlated.ctx, logger.LOG_LEVEL_INFO, "call served", "method", info.FullMethod, "code", status.Code(err).String())
+	return err
+}
+
+// correlatedStream is a stream whose context carries the call's correlation id.
+type correlatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *correlatedStream) Context() context.Context {
+	return stream.ctx
+}
+
+// statusError turns err into the gRPC status it maps to, hiding the details of server side errors.
+// Validation errors carry their fields as a BadRequest detail.
+func (srv *Server) statusError(ctx context.Context, err error) error {
+	var invalid validation.Errors
+	if errors.As(err, &invalid) {
+		violations := &errdetails.BadRequest{}
+		for _, e := range invalid {
+			violations.FieldViolations = append(violations.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       e.Field,
+				Description: e.Err.Error(),
+			})
+		}
+		st, detailErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(violations)
+		if detailErr != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return st.Err()
+	}
+	for _, target := range invalidArguments {
+		if errors.Is(err, target) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	for _, target := range notFounds {
+		if errors.Is(err, target) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+	}
+	switch {
+	case errors.Is(err, cli.ErrObjectAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	srv.logger.LogContext(ctx, logger.LOG_LEVEL_ERR, err.Error())
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// filterArgs collects the `key=value` args the cli list commands take, so filters are checked by the same rules.
+type filterArgs []string
+
+func (args *filterArgs) text(key string, value string) {
+	if value != "" {
+		*args = append(*args, key+"="+value)
+	}
+}
+
+func (args *filterArgs) uint(key string, value uint32) {
+	if value != 0 {
+		*args = append(*args, key+"="+strconv.FormatUint(uint64(value), 10))
+	}
+}
+
+func (args *filterArgs) float(key string, value *float64) {
+	if value != nil {
+		*args = append(*args, key+"="+strconv.FormatFloat(*value, 'f', -1, 64))
+	}
+}
+
+func (args *filterArgs) yesNo(key string, value *bool) {
+	if value != nil {
+		*args = append(*args, key+"="+strconv.FormatBool(*value))
+	}
+}
+
+// page collects the sort and limit of p, the cursor is set on the page the args are read into.
+func (args *filterArgs) page(p *schoolpb.Page) {
+	args.text("sort", p.GetSort())
+	args.uint("limit", p.GetLimit())
+}
@@ -0,0 +1,260 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/schoolpb"
+	"github.com/xHappyface/school/logger"
+)
+
+const (
+	TEST_MILLISECONDS uint = 10_000
+	// STREAMED spans several pages of the default size, so streams have cursors to follow.
+	STREAMED = 2*int(pagination.DEFAULT_LIMIT) + 5
+)
+
+// testClient serves a memory backend over an in-process connection and returns a client of it.
+func testClient(t *testing.T) schoolpb.SchoolClient {
+	t.Helper()
+	l := logger.New()
+	err := l.Configure(&logger.Config{Level: logger.LOG_LEVEL_ERR, Format: logger.FORMAT_TEXT, Sinks: []string{logger.SINK_STDERR}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sch, err := ports.NewSchoolService(l, ports.BACKEND_MEMORY, "", TEST_MILLISECONDS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sch.DB.Close() })
+	listener := bufconn.Listen(1 << 20)
+	server := NewServer(sch, l).grpcServer()
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return schoolpb.NewSchoolClient(conn)
+}
+
+func testProfessor(name string) *schoolpb.Professor {
+	return &schoolpb.Professor{Name: name, Age: 40, Address: "1 College Road", Phone: 5550100, Salary: 5000.5}
+}
+
+func testStudent(name string) *schoolpb.Student {
+	return &schoolpb.Student{Name: name, Age: 20, Address: "1 College Road", Phone: 5550200}
+}
+
+// letters returns a person name suffix unique to i.
+func letters(i int) string {
+	return string([]rune{rune('A' + i/26), rune('A' + i%26)})
+}
+
+func TestStatusCodes(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	course, err := client.CreateCourse(ctx, &schoolpb.CreateCourseRequest{Course: &schoolpb.Course{Name: "MATH 101"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = client.CreateProfessor(ctx, &schoolpb.CreateProfessorRequest{Professor: testProfessor("ADA LOVELACE")}); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{"get course", func() error {
+			_, err := client.GetCourse(ctx, &schoolpb.GetCourseRequest{Id: course.Id})
+			return err
+		}, codes.OK},
+		{"get missing course", func() error {
+			_, err := client.GetCourse(ctx, &schoolpb.GetCourseRequest{Id: uuid.NewString()})
+			return err
+		}, codes.NotFound},
+		{"update missing student", func() error {
+			student := testStudent("GRACE HOPPER")
+			student.Id = uuid.NewString()
+			_, err := client.UpdateStudent(ctx, &schoolpb.UpdateStudentRequest{Student: student})
+			return err
+		}, codes.NotFound},
+		{"delete missing professor", func() error {
+			_, err := client.DeleteProfessor(ctx, &schoolpb.DeleteProfessorRequest{Id: uuid.NewString()})
+			return err
+		}, codes.NotFound},
+		{"create without object", func() error {
+			_, err := client.CreateCourse(ctx, &schoolpb.CreateCourseRequest{})
+			return err
+		}, codes.InvalidArgument},
+		{"create invalid course", func() error {
+			_, err := client.CreateCourse(ctx, &schoolpb.CreateCourseRequest{Course: &schoolpb.Course{Name: "MATH_101"}})
+			return err
+		}, codes.InvalidArgument},
+		{"create duplicate course", func() error {
+			_, err := client.CreateCourse(ctx, &schoolpb.CreateCourseRequest{Course: &schoolpb.Course{Name: "math 101"}})
+			return err
+		}, codes.AlreadyExists},
+		{"create duplicate professor", func() error {
+			_, err := client.CreateProfessor(ctx, &schoolpb.CreateProfessorRequest{Professor: testProfessor("Ada  Lovelace")})
+			return err
+		}, codes.AlreadyExists},
+		{"list with invalid cursor", func() error {
+			_, err := client.ListCourses(ctx, &schoolpb.ListCoursesRequest{Page: &schoolpb.Page{Cursor: "not a cursor"}})
+			return err
+		}, codes.InvalidArgument},
+		{"list with invalid sort", func() error {
+			_, err := client.ListCourses(ctx, &schoolpb.ListCoursesRequest{Page: &schoolpb.Page{Sort: pagination.SORT_SALARY}})
+			return err
+		}, codes.InvalidArgument},
+		{"stream with invalid sort", func() error {
+			stream, err := client.StreamStudents(ctx, &schoolpb.StreamStudentsRequest{Sort: "phone"})
+			if err != nil {
+				return err
+			}
+			_, err = stream.Recv()
+			return err
+		}, codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.call()
+			if got := status.Code(err); got != test.want {
+				t.Errorf("got %s (%v), want %s", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestFieldViolations(t *testing.T) {
+	client := testClient(t)
+	professor := &schoolpb.Professor{Name: "a", Address: "1 College Road", Salary: -1}
+	_, err := client.CreateProfessor(context.Background(), &schoolpb.CreateProfessorRequest{Professor: professor})
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("got %s (%v), want %s", st.Code(), err, codes.InvalidArgument)
+	}
+	got := []string{}
+	for _, detail := range st.Details() {
+		violations, ok := detail.(*errdetails.BadRequest)
+		if !(ok) {
+			t.Fatalf("got detail %T, want *errdetails.BadRequest", detail)
+		}
+		for _, violation := range violations.GetFieldViolations() {
+			if violation.GetDescription() == "" {
+				t.Errorf("field %s violated without a description", violation.GetField())
+			}
+			got = append(got, violation.GetField())
+		}
+	}
+	if want := []string{"name", "age", "phone", "salary"}; !(reflect.DeepEqual(got, want)) {
+		t.Errorf("got violations of %q, want %q", got, want)
+	}
+}
+
+// receive reads stream to its end, returning the ids it sent.
+func receive[T interface{ GetId() string }](t *testing.T, stream interface{ Recv() (T, error) }) []string {
+	t.Helper()
+	ids := []string{}
+	for _, object := range receiveAll(t, stream) {
+		ids = append(ids, object.GetId())
+	}
+	return ids
+}
+
+// receiveAll reads stream to its end.
+func receiveAll[T any](t *testing.T, stream interface{ Recv() (T, error) }) []T {
+	t.Helper()
+	list := []T{}
+	for {
+		object, err := stream.Recv()
+		if err == io.EOF {
+			return list
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		list = append(list, object)
+	}
+}
+
+func TestStreams(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	courseIDs, professorIDs, studentIDs := []string{}, []string{}, []string{}
+	for i := 0; i < STREAMED; i++ {
+		course, err := client.CreateCourse(ctx, &schoolpb.CreateCourseRequest{Course: &schoolpb.Course{Name: fmt.Sprintf("COURSE %02d", i)}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		professor := testProfessor("PROFESSOR " + letters(i))
+		// ages repeat, so the age cursor has ties to break by id
+		professor.Age = uint32(30 + i%3)
+		if professor, err = client.CreateProfessor(ctx, &schoolpb.CreateProfessorRequest{Professor: professor}); err != nil {
+			t.Fatal(err)
+		}
+		student, err := client.CreateStudent(ctx, &schoolpb.CreateStudentRequest{Student: testStudent("STUDENT " + letters(i))})
+		if err != nil {
+			t.Fatal(err)
+		}
+		courseIDs = append(courseIDs, course.Id)
+		professorIDs = append(professorIDs, professor.Id)
+		studentIDs = append(studentIDs, student.Id)
+	}
+	// a course the filter leaves out
+	if _, err := client.CreateCourse(ctx, &schoolpb.CreateCourseRequest{Course: &schoolpb.Course{Name: "ART"}}); err != nil {
+		t.Fatal(err)
+	}
+	courses, err := client.StreamCourses(ctx, &schoolpb.StreamCoursesRequest{Filter: &schoolpb.CourseFilter{NamePrefix: "COURSE"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// courses are named in creation order, so the name sort streams them in it
+	if got := receive[*schoolpb.Course](t, courses); !(reflect.DeepEqual(got, courseIDs)) {
+		t.Errorf("got courses %q, want %q", got, courseIDs)
+	}
+	professors, err := client.StreamProfessors(ctx, &schoolpb.StreamProfessorsRequest{Sort: pagination.SORT_AGE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, professorAges := []string{}, []uint32{}
+	for i, professor := range receiveAll[*schoolpb.Professor](t, professors) {
+		if i > 0 && professor.Age < professorAges[i-1] {
+			t.Errorf("professor %d of age %d streamed after age %d", i, professor.Age, professorAges[i-1])
+		}
+		professorAges = append(professorAges, professor.Age)
+		got = append(got, professor.Id)
+	}
+	sort.Strings(got)
+	sort.Strings(professorIDs)
+	if !(reflect.DeepEqual(got, professorIDs)) {
+		t.Errorf("got professors %q, want %q", got, professorIDs)
+	}
+	students, err := client.StreamStudents(ctx, &schoolpb.StreamStudentsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := receive[*schoolpb.Student](t, students); !(reflect.DeepEqual(got, studentIDs)) {
+		t.Errorf("got students %q, want %q", got, studentIDs)
+	}
+}
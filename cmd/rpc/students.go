@@ -0,0 +1,151 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/schoolpb"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/pkg/cli"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+func studentPB(student *students.Student) *schoolpb.Student {
+	return &schoolpb.Student{
+		Id:              student.ID,
+		Name:            student.Name,
+		Age:             uint32(student.Age),
+		Address:         student.Address,
+		Phone:           uint64(student.Phone),
+		IfInternational: student.IfInternational,
+		IfOnProbation:   student.IfOnProbation,
+	}
+}
+
+func studentOf(msg *schoolpb.Student) (*students.Student, error) {
+	if msg == nil {
+		return new(students.Student), errMissingObject
+	}
+	if msg.GetAge() > math.MaxUint8 {
+		return new(students.Student), cli.ErrInvalidAge
+	}
+	return &students.Student{
+		ID:              msg.GetId(),
+		Name:            msg.GetName(),
+		Age:             uint8(msg.GetAge()),
+		Address:         msg.GetAddress(),
+		Phone:           uint(msg.GetPhone()),
+		IfInternational: msg.GetIfInternational(),
+		IfOnProbation:   msg.GetIfOnProbation(),
+	}, nil
+}
+
+func studentArgs(filter *schoolpb.StudentFilter) filterArgs {
+	args := filterArgs{}
+	args.text("name", filter.GetNamePrefix())
+	args.uint("min_age", filter.GetMinAge())
+	args.uint("max_age", filter.GetMaxAge())
+	if filter != nil {
+		args.yesNo("international", filter.IfInternational)
+		args.yesNo("probation", filter.IfOnProbation)
+	}
+	return args
+}
+
+func (srv *Server) CreateStudent(ctx context.Context, req *schoolpb.CreateStudentRequest) (*schoolpb.Student, error) {
+	student, err := studentOf(req.GetStudent())
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	student.ID = uuid.NewString()
+	if err = cli.CheckStudent(student); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	if err = cli.CreateStudent(ctx, srv.sch, student); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return studentPB(student), nil
+}
+
+func (srv *Server) GetStudent(ctx context.Context, req *schoolpb.GetStudentRequest) (*schoolpb.Student, error) {
+	student, err := srv.sch.StudentRepo.ReadByID(ctx, req.GetId())
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		err = cli.ErrStudentNotFound
+	}
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return studentPB(student), nil
+}
+
+func (srv *Server) UpdateStudent(ctx context.Context, req *schoolpb.UpdateStudentRequest) (*schoolpb.Student, error) {
+	student, err := studentOf(req.GetStudent())
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	if err = cli.CheckStudent(student); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	if err = cli.SaveStudent(ctx, srv.sch, student); err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return studentPB(student), nil
+}
+
+func (srv *Server) DeleteStudent(ctx context.Context, req *schoolpb.DeleteStudentRequest) (*schoolpb.DeleteStudentResponse, error) {
+	err := srv.sch.StudentRepo.DeleteByID(ctx, req.GetId())
+	if errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		err = cli.ErrStudentNotFound
+	}
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	return &schoolpb.DeleteStudentResponse{}, nil
+}
+
+func (srv *Server) ListStudents(ctx context.Context, req *schoolpb.ListStudentsRequest) (*schoolpb.ListStudentsResponse, error) {
+	args := studentArgs(req.GetFilter())
+	args.page(req.GetPage())
+	filter, page, err := cli.StudentsQuery(args)
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	page.Cursor = req.GetPage().GetCursor()
+	list, next, err := srv.sch.StudentRepo.List(ctx, filter, page)
+	if err != nil {
+		return nil, srv.statusError(ctx, err)
+	}
+	resp := &schoolpb.ListStudentsResponse{NextCursor: next}
+	for _, student := range list {
+		resp.Students = append(resp.Students, studentPB(student))
+	}
+	return resp, nil
+}
+
+// StreamStudents sends every student matching the filter, one page read at a time.
+func (srv *Server) StreamStudents(req *schoolpb.StreamStudentsRequest, stream schoolpb.School_StreamStudentsServer) error {
+	ctx := stream.Context()
+	args := studentArgs(req.GetFilter())
+	args.text("sort", req.GetSort())
+	filter, page, err := cli.StudentsQuery(args)
+	if err != nil {
+		return srv.statusError(ctx, err)
+	}
+	for {
+		list, next, err := srv.sch.StudentRepo.List(ctx, filter, page)
+		if err != nil {
+			return srv.statusError(ctx, err)
+		}
+		for _, student := range list {
+			if err = stream.Send(studentPB(student)); err != nil {
+				return err
+			}
+		}
+		if next == "" {
+			return nil
+		}
+		page.Cursor = next
+	}
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"github.com/xHappyface/school/api/assignments"
+	"github.com/xHappyface/school/pkg/cli"
+)
+
+// HandleCmdAssign expects `assign professor <professor id> <course id> [role]`.
+func (handler *SchoolHandler) HandleCmdAssign() error {
+	if handler.obj != "professor" {
+		return errInvalidObject
+	}
+	if !(len(handler.args) >= 2) {
+		return errTooFewArgs
+	}
+	role := assignments.ROLE_LEAD
+	if len(handler.args) > 2 {
+		role = handler.args[2]
+	}
+	return cli.AssignProfessor(handler.ctx, handler.messages(), handler.sch, handler.args[0], handler.args[1], role)
+}
+
+// HandleCmdUnassign expects `unassign professor <professor id> <course id>`.
+func (handler *SchoolHandler) HandleCmdUnassign() error {
+	if handler.obj != "professor" {
+		return errInvalidObject
+	}
+	if !(len(handler.args) >= 2) {
+		return errTooFewArgs
+	}
+	return cli.UnassignProfessor(handler.ctx, handler.messages(), handler.sch, handler.args[0], handler.args[1])
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"github.com/xHappyface/school/pkg/cli"
+)
+
+// HandleCmdDelete expects `delete <object> <id> [yes|no]`, asking for confirmation
+// when not given unless the session is a script.
+func (handler *SchoolHandler) HandleCmdDelete() error {
+	if !(len(handler.args) > 0) {
+		return errTooFewArgs
+	}
+	id, confirmation := handler.args[0], ""
+	if len(handler.args) > 1 {
+		confirmation = handler.args[1]
+	}
+	switch handler.obj {
+	case "course":
+		return cli.DeleteCourse(handler.ctx, handler.answers(), handler.messages(), handler.sch.CourseRepo, id, confirmation)
+	case "professor":
+		return cli.DeleteProfessor(handler.ctx, handler.answers(), handler.messages(), handler.sch.ProfessorRepo, id, confirmation)
+	case "student":
+		return cli.DeleteStudent(handler.ctx, handler.answers(), handler.messages(), handler.sch.StudentRepo, id, confirmation)
+	default:
+		return errInvalidObject
+	}
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"github.com/xHappyface/school/pkg/cli"
+)
+
+// HandleCmdEnroll expects `enroll student <student id> <course id>`.
+func (handler *SchoolHandler) HandleCmdEnroll() error {
+	if handler.obj != "student" {
+		return errInvalidObject
+	}
+	if !(len(handler.args) >= 2) {
+		return errTooFewArgs
+	}
+	return cli.EnrollStudent(handler.ctx, handler.messages(), handler.sch, handler.args[0], handler.args[1])
+}
+
+// HandleCmdDrop expects `drop student <student id> <course id>`.
+func (handler *SchoolHandler) HandleCmdDrop() error {
+	if handler.obj != "student" {
+		return errInvalidObject
+	}
+	if !(len(handler.args) >= 2) {
+		return errTooFewArgs
+	}
+	return cli.DropStudent(handler.ctx, handler.messages(), handler.sch, handler.args[0], handler.args[1])
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"io"
+
+	"github.com/xHappyface/school/pkg/cli"
+)
+
+// HandleCmdList expects `list <object> [key=value...]`, the object may be plural.
+func (handler *SchoolHandler) HandleCmdList() error {
+	switch handler.obj {
+	case "course", "courses":
+		return cli.ListCourses(handler.ctx, handler.pager(), handler.w, handler.sch.CourseRepo, handler.args, handler.opts.Format)
+	case "professor", "professors":
+		return cli.ListProfessors(handler.ctx, handler.pager(), handler.w, handler.sch.ProfessorRepo, handler.args, handler.opts.Format)
+	case "student", "students":
+		return cli.ListStudents(handler.ctx, handler.pager(), handler.w, handler.sch.StudentRepo, handler.args, handler.opts.Format)
+	default:
+		return errInvalidObject
+	}
+}
+
+// pager is the reader list pages are confirmed from, nil to list every page at once.
+func (handler *SchoolHandler) pager() io.Reader {
+	if !(handler.opts.Interactive) {
+		return nil
+	}
+	return handler.r
+}
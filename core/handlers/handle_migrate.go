@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/pkg/cli"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+func (handler *SchoolHandler) HandleCmdMigrate() error {
+	if handler.sch.Migrator == nil {
+		return errMigrationsUnsupported
+	}
+	switch handler.obj {
+	case "up":
+		return handler.sch.Migrator.Up(handler.ctx)
+	case "down":
+		return handler.sch.Migrator.Down(handler.ctx)
+	case "status":
+		statuses, err := handler.sch.Migrator.Status(handler.ctx)
+		if err != nil {
+			return err
+		}
+		if handler.opts.Format != cli.FORMAT_TABLE {
+			return cli.RenderMigrations(handler.w, handler.opts.Format, statuses)
+		}
+		printMigrationStatus(handler, statuses)
+	default:
+		return errInvalidObject
+	}
+	return nil
+}
+
+func printMigrationStatus(handler *SchoolHandler, statuses []*mysql_db.MigrationStatus) {
+	for _, status := range statuses {
+		applied := "pending"
+		if status.Applied {
+			applied = "applied " + status.AppliedAt.Format(time.DateTime)
+		}
+		fmt.Fprintf(handler.w, "%04d_%-24s %s\n", status.Version, status.Name, applied)
+	}
+}
+
+// CheckSchema reports mysql_db.ErrSchemaOutOfDate when the service's backend has pending migrations.
+func CheckSchema(ctx context.Context, sch *ports.SchoolService) error {
+	if sch.Migrator == nil {
+		return nil
+	}
+	return sch.Migrator.CheckCurrent(ctx)
+}
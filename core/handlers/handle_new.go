@@ -1,24 +1,24 @@
 package handlers
 
 import (
-	"github.com/xHappyface/school/api/professors"
-	"github.com/xHappyface/school/api/students"
 	"github.com/xHappyface/school/pkg/cli"
 )
 
+// HandleCmdNew expects `new <object> [key=value...]`, prompting for the fields not given
+// unless the session is a script.
 func (handler *SchoolHandler) HandleCmdNew() error {
 	var err error
 	switch handler.obj {
 	case "course":
-		if err = cli.NewCourse(handler.r, handler.w, handler.sch.CourseRepo); err != nil {
+		if err = cli.NewCourse(handler.ctx, handler.answers(), handler.messages(), handler.sch, handler.args); err != nil {
 			return err
 		}
 	case "professor":
-		if err = professors.NewProfessor(handler.r, handler.w); err != nil {
+		if err = cli.NewProfessor(handler.ctx, handler.answers(), handler.messages(), handler.sch, handler.args); err != nil {
 			return err
 		}
 	case "student":
-		if err = students.NewStudent(handler.r, handler.w); err != nil {
+		if err = cli.NewStudent(handler.ctx, handler.answers(), handler.messages(), handler.sch, handler.args); err != nil {
 			return err
 		}
 	default:
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/xHappyface/school/pkg/cli"
+)
+
+// HandleCmdShow expects `show <object> <id|name>`.
+func (handler *SchoolHandler) HandleCmdShow() error {
+	if !(len(handler.args) > 0) {
+		return errTooFewArgs
+	}
+	key := strings.Join(handler.args, " ")
+	switch handler.obj {
+	case "course":
+		return cli.ShowCourse(handler.ctx, handler.w, handler.sch, key, handler.opts.Format)
+	case "professor":
+		return cli.ShowProfessor(handler.ctx, handler.w, handler.sch, key, handler.opts.Format)
+	case "student":
+		return cli.ShowStudent(handler.ctx, handler.w, handler.sch, key, handler.opts.Format)
+	default:
+		return errInvalidObject
+	}
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"github.com/xHappyface/school/pkg/cli"
+)
+
+// HandleCmdUpdate expects `update <object> <id> [key=value...]`, prompting for
+// every field when none is given unless the session is a script.
+func (handler *SchoolHandler) HandleCmdUpdate() error {
+	if !(len(handler.args) > 0) {
+		return errTooFewArgs
+	}
+	id, fields := handler.args[0], handler.args[1:]
+	switch handler.obj {
+	case "course":
+		return cli.UpdateCourse(handler.ctx, handler.answers(), handler.messages(), handler.sch, id, fields)
+	case "professor":
+		return cli.UpdateProfessor(handler.ctx, handler.answers(), handler.messages(), handler.sch, id, fields)
+	case "student":
+		return cli.UpdateStudent(handler.ctx, handler.answers(), handler.messages(), handler.sch, id, fields)
+	default:
+		return errInvalidObject
+	}
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"io"
 
@@ -8,23 +9,57 @@ import (
 )
 
 var (
-	errInvalidObject = errors.New("invalid object")
+	errInvalidObject         = errors.New("invalid object")
+	errTooFewArgs            = errors.New("too few args")
+	errMigrationsUnsupported = errors.New("storage backend does not support migrations")
 )
 
+// Options are the settings of the session a handler runs in.
+type Options struct {
+	// Interactive is false when the statements come from a script, which nothing answers questions like `more` for.
+	Interactive bool
+	// Format is the output format of list, show and migrate status, one of the cli.FORMAT_* constants.
+	Format string
+	// Messages receives the prompts and confirmations of the commands changing data
+	// in place of the handler's writer, nil to write them along with the output.
+	Messages io.Writer
+}
+
 type SchoolHandler struct {
+	ctx  context.Context
 	r    io.Reader
 	w    io.Writer
 	sch  *ports.SchoolService
 	obj  string
 	args []string
+	opts Options
 }
 
-func NewSchoolHandler(r io.Reader, w io.Writer, sch *ports.SchoolService, obj string, args []string) *SchoolHandler {
+func NewSchoolHandler(ctx context.Context, r io.Reader, w io.Writer, sch *ports.SchoolService, obj string, args []string, opts Options) *SchoolHandler {
 	return &SchoolHandler{
+		ctx:  ctx,
 		r:    r,
 		w:    w,
 		sch:  sch,
 		obj:  obj,
 		args: args,
+		opts: opts,
+	}
+}
+
+// answers is the reader prompts are answered from, nil in scripts where
+// every field is given inline.
+func (handler *SchoolHandler) answers() io.Reader {
+	if !(handler.opts.Interactive) {
+		return nil
+	}
+	return handler.r
+}
+
+// messages is where prompts and confirmations meant for a person are written.
+func (handler *SchoolHandler) messages() io.Writer {
+	if handler.opts.Messages == nil {
+		return handler.w
 	}
+	return handler.opts.Messages
 }
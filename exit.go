@@ -0,0 +1,47 @@
+package main
+
+import "errors"
+
+// Exit codes of the failure classes main tells apart. Flag errors exit with EXIT_CONFIG too.
+const (
+	EXIT_OK         = 0
+	EXIT_RUNTIME    = 1
+	EXIT_CONFIG     = 2
+	EXIT_CONNECTION = 3
+)
+
+// exitError is a failure run returns to main, which logs it and exits with code.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exitError) Unwrap() error {
+	return e.err
+}
+
+// configError marks err as caused by the flags, the environment or the files they name.
+func configError(err error) error {
+	return &exitError{code: EXIT_CONFIG, err: err}
+}
+
+// connectionError marks err as a failure to reach the database.
+func connectionError(err error) error {
+	return &exitError{code: EXIT_CONNECTION, err: err}
+}
+
+// exitCode is the code main exits with after err, EXIT_RUNTIME unless err is marked otherwise.
+func exitCode(err error) int {
+	if err == nil {
+		return EXIT_OK
+	}
+	var exit *exitError
+	if errors.As(err, &exit) {
+		return exit.code
+	}
+	return EXIT_RUNTIME
+}
@@ -0,0 +1,151 @@
+package logger
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FORMAT_TEXT string = "text"
+	FORMAT_JSON string = "json"
+
+	SINK_STDERR string = "stderr"
+)
+
+var (
+	errInvalidLogFormat = errors.New("invalid log format, expected text or json")
+	errInvalidRotation  = errors.New("invalid log rotation setting")
+)
+
+// Config selects what a logger writes and where.
+// Sinks are SINK_STDERR or paths of files entries are appended to, rotated as Rotate describes.
+type Config struct {
+	Level  uint8
+	Format string
+	Sinks  []string
+	Rotate RotateConfig
+}
+
+// ParseLevel reads a minimum level named info, wrn or err, warn and error being accepted too.
+func ParseLevel(s string) (uint8, error) {
+	switch strings.ToLower(s) {
+	case "info":
+		return LOG_LEVEL_INFO, nil
+	case "wrn", "warn", "warning":
+		return LOG_LEVEL_WRN, nil
+	case "err", "error":
+		return LOG_LEVEL_ERR, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", errInvalidLogLevel, s)
+	}
+}
+
+// ConfigFromEnv reads LOG_LEVEL, LOG_FORMAT and the comma separated LOG_OUTPUT,
+// defaulting to text entries of every level on stderr, and the rotation of file
+// sinks from LOG_MAX_SIZE_MB, LOG_MAX_AGE_DAYS, LOG_MAX_BACKUPS and LOG_COMPRESS.
+func ConfigFromEnv() (*Config, error) {
+	cfg := &Config{Level: LOG_LEVEL_INFO, Format: FORMAT_TEXT, Sinks: []string{SINK_STDERR}}
+	if level := os.Getenv("LOG_LEVEL"); level != "" {
+		lv, err := ParseLevel(level)
+		if err != nil {
+			return new(Config), err
+		}
+		cfg.Level = lv
+	}
+	if format := os.Getenv("LOG_FORMAT"); format != "" {
+		cfg.Format = strings.ToLower(format)
+	}
+	if output := os.Getenv("LOG_OUTPUT"); output != "" {
+		cfg.Sinks = cfg.Sinks[:0]
+		for _, sink := range strings.Split(output, ",") {
+			if sink = strings.TrimSpace(sink); sink != "" {
+				cfg.Sinks = append(cfg.Sinks, sink)
+			}
+		}
+	}
+	megabytes, err := envUint("LOG_MAX_SIZE_MB")
+	if err != nil {
+		return new(Config), err
+	}
+	days, err := envUint("LOG_MAX_AGE_DAYS")
+	if err != nil {
+		return new(Config), err
+	}
+	backups, err := envUint("LOG_MAX_BACKUPS")
+	if err != nil {
+		return new(Config), err
+	}
+	cfg.Rotate = RotateConfig{
+		MaxSize:    int64(megabytes) << 20,
+		MaxAge:     time.Duration(days) * 24 * time.Hour,
+		MaxBackups: int(backups),
+	}
+	if compress := os.Getenv("LOG_COMPRESS"); compress != "" {
+		if cfg.Rotate.Compress, err = strconv.ParseBool(compress); err != nil {
+			return new(Config), fmt.Errorf("%w: LOG_COMPRESS=%s", errInvalidRotation, compress)
+		}
+	}
+	return cfg, nil
+}
+
+// envUint reads the variable key as a count, zero when unset.
+func envUint(key string) (uint, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseUint(value, 10, 31)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s=%s", errInvalidRotation, key, value)
+	}
+	return uint(n), nil
+}
+
+// Configure applies cfg to the logger and those sharing its output, closing the file sinks it replaces.
+func (logger *SchoolLogger) Configure(cfg *Config) error {
+	var enc encoder
+	switch cfg.Format {
+	case FORMAT_TEXT, "":
+		enc = encodeText
+	case FORMAT_JSON:
+		enc = encodeJSON
+	default:
+		return fmt.Errorf("%w: %s", errInvalidLogFormat, cfg.Format)
+	}
+	if cfg.Level < LOG_LEVEL_INFO || cfg.Level > LOG_LEVEL_FATAL_ERR {
+		return errInvalidLogLevel
+	}
+	sinks := []io.Writer{}
+	closers := []io.Closer{}
+	for _, name := range cfg.Sinks {
+		if name == SINK_STDERR {
+			sinks = append(sinks, os.Stderr)
+			continue
+		}
+		f, err := NewRotatingFile(name, cfg.Rotate)
+		if err != nil {
+			for _, closer := range closers {
+				closer.Close()
+			}
+			return err
+		}
+		sinks = append(sinks, f)
+		closers = append(closers, f)
+	}
+	if err := logger.Close(); err != nil {
+		for _, closer := range closers {
+			closer.Close()
+		}
+		return err
+	}
+	out := logger.out
+	out.mu.Lock()
+	defer out.mu.Unlock()
+	out.level, out.encoder, out.sinks, out.closers = cfg.Level, enc, sinks, closers
+	return nil
+}
@@ -0,0 +1,30 @@
+package logger
+
+import "context"
+
+const (
+	CORRELATION_ID_KEY string = "correlation_id"
+	// SESSION_ID_KEY names the field tying entries to one cli session rather than one statement.
+	SESSION_ID_KEY string = "session_id"
+)
+
+type correlationKey struct{}
+
+// WithCorrelationID returns ctx carrying id, tying the entries logged with it to one statement or request.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationKey{}, id)
+}
+
+// CorrelationID returns the id ctx carries, empty when it carries none.
+func CorrelationID(ctx context.Context) string {
+	id, _ := ctx.Value(correlationKey{}).(string)
+	return id
+}
+
+// LogContext is Log with the correlation id of ctx as the first field.
+func (logger *SchoolLogger) LogContext(ctx context.Context, lv uint8, msg string, kv ...any) {
+	if id := CorrelationID(ctx); id != "" {
+		kv = append([]any{CORRELATION_ID_KEY, id}, kv...)
+	}
+	logger.Log(lv, msg, kv...)
+}
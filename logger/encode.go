@@ -0,0 +1,98 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const BAD_KEY string = "!BADKEY"
+
+type entry struct {
+	time   time.Time
+	level  uint8
+	msg    string
+	fields []any
+}
+
+// encoder turns an entry into one line, newline included.
+type encoder func(e *entry) []byte
+
+func levelName(lv uint8) string {
+	switch lv {
+	case LOG_LEVEL_INFO:
+		return PREFIX_INFO
+	case LOG_LEVEL_WRN:
+		return PREFIX_WRN
+	default:
+		return PREFIX_ERR
+	}
+}
+
+// pairs calls fn for each key/value pair of fields, a value without a key is paired with BAD_KEY.
+func pairs(fields []any, fn func(key string, value any)) {
+	for i := 0; i < len(fields); i += 2 {
+		if i+1 == len(fields) {
+			fn(BAD_KEY, fields[i])
+			return
+		}
+		key, ok := fields[i].(string)
+		if !ok {
+			key = fmt.Sprint(fields[i])
+		}
+		fn(key, fields[i+1])
+	}
+}
+
+// encodeText writes `15:04:05 SCHOOL:INFO: msg key=value`, quoting values with spaces, quotes or equal signs.
+func encodeText(e *entry) []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteString(e.time.Format("15:04:05 "))
+	buf.WriteString(PREFIX_APP + levelName(e.level) + ": " + e.msg)
+	pairs(e.fields, func(key string, value any) {
+		text := fmt.Sprint(value)
+		if text == "" || strings.ContainsAny(text, " \t\n\"=") {
+			text = strconv.Quote(text)
+		}
+		buf.WriteString(" " + key + "=" + text)
+	})
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
+
+// encodeJSON writes an object with the time, level and msg followed by the fields in order.
+func encodeJSON(e *entry) []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteString(`{"time":`)
+	writeJSONValue(buf, e.time.Format(time.RFC3339Nano))
+	buf.WriteString(`,"level":`)
+	writeJSONValue(buf, levelName(e.level))
+	buf.WriteString(`,"msg":`)
+	writeJSONValue(buf, e.msg)
+	pairs(e.fields, func(key string, value any) {
+		buf.WriteByte(',')
+		writeJSONValue(buf, key)
+		buf.WriteByte(':')
+		writeJSONValue(buf, value)
+	})
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// writeJSONValue writes errors as their message and values json cannot encode as fmt prints them.
+func writeJSONValue(buf *bytes.Buffer, value any) {
+	switch v := value.(type) {
+	case error:
+		value = v.Error()
+	case time.Duration:
+		value = v.String()
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		b, _ = json.Marshal(fmt.Sprint(value))
+	}
+	buf.Write(b)
+}
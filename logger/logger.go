@@ -2,9 +2,10 @@ package logger
 
 import (
 	"errors"
-	"fmt"
-	"log"
+	"io"
 	"os"
+	"sync"
+	"time"
 )
 
 const (
@@ -25,25 +26,96 @@ var (
 	errInvalidLogLevel = errors.New("invalid log level")
 )
 
+// SchoolLogger writes entries at or above its minimum level to its sinks.
+// Loggers made by With share their parent's output and add fields to every entry.
 type SchoolLogger struct {
-	stderr *log.Logger
+	out    *output
+	fields []any
 }
 
+// output is the configuration shared by a logger and those made from it by With.
+type output struct {
+	mu      sync.Mutex
+	level   uint8
+	encoder encoder
+	sinks   []io.Writer
+	closers []io.Closer
+}
+
+// New returns a logger writing text entries of every level to stderr.
 func New() *SchoolLogger {
 	return &SchoolLogger{
-		stderr: log.New(os.Stderr, PREFIX_APP, log.Ltime|log.Lmsgprefix),
+		out: &output{
+			level:   LOG_LEVEL_INFO,
+			encoder: encodeText,
+			sinks:   []io.Writer{os.Stderr},
+		},
+	}
+}
+
+// With returns a logger adding the key/value pairs kv to every entry.
+func (logger *SchoolLogger) With(kv ...any) *SchoolLogger {
+	fields := make([]any, 0, len(logger.fields)+len(kv))
+	fields = append(fields, logger.fields...)
+	return &SchoolLogger{
+		out:    logger.out,
+		fields: append(fields, kv...),
+	}
+}
+
+// Enabled tells whether entries of level lv are written.
+func (logger *SchoolLogger) Enabled(lv uint8) bool {
+	logger.out.mu.Lock()
+	defer logger.out.mu.Unlock()
+	return lv >= logger.out.level
+}
+
+// Log writes msg with the key/value pairs kv when lv is at or above the minimum level.
+// A LOG_LEVEL_FATAL_ERR entry is always written. It does not exit, the failure
+// is returned up to main which cleans up and picks the exit code.
+func (logger *SchoolLogger) Log(lv uint8, msg string, kv ...any) {
+	if lv < LOG_LEVEL_INFO || lv > LOG_LEVEL_FATAL_ERR {
+		return
+	}
+	now := time.Now()
+	out := logger.out
+	out.mu.Lock()
+	defer out.mu.Unlock()
+	if lv < out.level && lv != LOG_LEVEL_FATAL_ERR {
+		return
+	}
+	fields := make([]any, 0, len(logger.fields)+len(kv))
+	fields = append(append(fields, logger.fields...), kv...)
+	line := out.encoder(&entry{time: now, level: lv, msg: msg, fields: fields})
+	for _, sink := range out.sinks {
+		sink.Write(line)
+	}
+}
+
+// Close closes the file sinks, entries logged afterwards only go to the other sinks.
+func (logger *SchoolLogger) Close() error {
+	out := logger.out
+	out.mu.Lock()
+	defer out.mu.Unlock()
+	errs := []error{}
+	sinks := []io.Writer{}
+	for _, sink := range out.sinks {
+		if !(closes(out.closers, sink)) {
+			sinks = append(sinks, sink)
+		}
+	}
+	for _, closer := range out.closers {
+		errs = append(errs, closer.Close())
 	}
+	out.sinks, out.closers = sinks, nil
+	return errors.Join(errs...)
 }
 
-func (logger *SchoolLogger) Log(lv uint8, msg string) {
-	switch lv {
-	case LOG_LEVEL_INFO:
-		logger.stderr.Println(fmt.Sprintf("%s: %s", PREFIX_INFO, msg))
-	case LOG_LEVEL_WRN:
-		logger.stderr.Println(fmt.Errorf("%s: %s", PREFIX_WRN, msg))
-	case LOG_LEVEL_ERR:
-		logger.stderr.Println(fmt.Errorf("%s: %s", PREFIX_ERR, msg))
-	case LOG_LEVEL_FATAL_ERR:
-		logger.stderr.Fatalln(fmt.Errorf("%s: %s", PREFIX_ERR, msg))
+func closes(closers []io.Closer, sink io.Writer) bool {
+	for _, closer := range closers {
+		if any(closer) == any(sink) {
+			return true
+		}
 	}
+	return false
 }
@@ -0,0 +1,212 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// bufferLogger returns a logger of minimum level lv writing entries encoded by enc to the buffer returned.
+func bufferLogger(lv uint8, enc encoder) (*SchoolLogger, *bytes.Buffer) {
+	buf := new(bytes.Buffer)
+	return &SchoolLogger{out: &output{level: lv, encoder: enc, sinks: []io.Writer{buf}}}, buf
+}
+
+// textLines returns the lines of text entries in buf without their time.
+func textLines(buf *bytes.Buffer) []string {
+	lines := []string{}
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		if line != "" {
+			lines = append(lines, line[len("15:04:05 "):])
+		}
+	}
+	return lines
+}
+
+func TestLevels(t *testing.T) {
+	tests := []struct {
+		level uint8
+		want  []string
+	}{
+		{LOG_LEVEL_INFO, []string{"SCHOOL:INFO: info", "SCHOOL:WRN: wrn", "SCHOOL:ERR: err", "SCHOOL:ERR: fatal"}},
+		{LOG_LEVEL_WRN, []string{"SCHOOL:WRN: wrn", "SCHOOL:ERR: err", "SCHOOL:ERR: fatal"}},
+		{LOG_LEVEL_ERR, []string{"SCHOOL:ERR: err", "SCHOOL:ERR: fatal"}},
+		{LOG_LEVEL_FATAL_ERR, []string{"SCHOOL:ERR: fatal"}},
+	}
+	for _, test := range tests {
+		l, buf := bufferLogger(test.level, encodeText)
+		l.Log(0, "below the levels")
+		l.Log(LOG_LEVEL_INFO, "info")
+		l.Log(LOG_LEVEL_WRN, "wrn")
+		l.Log(LOG_LEVEL_ERR, "err")
+		l.Log(LOG_LEVEL_FATAL_ERR, "fatal")
+		l.Log(LOG_LEVEL_FATAL_ERR+1, "above the levels")
+		if got := textLines(buf); !(reflect.DeepEqual(got, test.want)) {
+			t.Errorf("level %d: got %q, want %q", test.level, got, test.want)
+		}
+		if !(l.Enabled(test.level)) || l.Enabled(test.level-1) {
+			t.Errorf("level %d: Enabled does not start at the level", test.level)
+		}
+	}
+}
+
+func TestWith(t *testing.T) {
+	parent, buf := bufferLogger(LOG_LEVEL_INFO, encodeText)
+	child := parent.With("session", "s1").With("user", "u1")
+	child.Log(LOG_LEVEL_INFO, "child", "k", "v")
+	parent.Log(LOG_LEVEL_INFO, "parent", "k", "v")
+	child.Log(LOG_LEVEL_INFO, "odd", 7, "seven", "lonely")
+	want := []string{
+		"SCHOOL:INFO: child session=s1 user=u1 k=v",
+		"SCHOOL:INFO: parent k=v",
+		"SCHOOL:INFO: odd session=s1 user=u1 7=seven " + BAD_KEY + "=lonely",
+	}
+	if got := textLines(buf); !(reflect.DeepEqual(got, want)) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	// loggers made by With share the output of their parent
+	if err := parent.Configure(&Config{Level: LOG_LEVEL_ERR, Format: FORMAT_TEXT}); err != nil {
+		t.Fatal(err)
+	}
+	if child.Enabled(LOG_LEVEL_WRN) {
+		t.Error("child kept its level after its parent was configured")
+	}
+}
+
+func TestTextQuoting(t *testing.T) {
+	tests := []struct {
+		value any
+		want  string
+	}{
+		{"plain", "k=plain"},
+		{"two words", `k="two words"`},
+		{"a=b", `k="a=b"`},
+		{`say "hi"`, `k="say \"hi\""`},
+		{"tab\there", `k="tab\there"`},
+		{"line\nbreak", `k="line\nbreak"`},
+		{"", `k=""`},
+		{42, "k=42"},
+		{errors.New("not found"), `k="not found"`},
+	}
+	for _, test := range tests {
+		l, buf := bufferLogger(LOG_LEVEL_INFO, encodeText)
+		l.Log(LOG_LEVEL_INFO, "m", "k", test.value)
+		if got, want := textLines(buf), []string{"SCHOOL:INFO: m " + test.want}; !(reflect.DeepEqual(got, want)) {
+			t.Errorf("%#v: got %q, want %q", test.value, got, want)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	l, buf := bufferLogger(LOG_LEVEL_INFO, encodeJSON)
+	l.With("session", "s1").Log(LOG_LEVEL_WRN, `a "quoted" msg`,
+		"err", errors.New("boom"),
+		"took", 1500*time.Millisecond,
+		"count", 3,
+		"ch", make(chan int),
+	)
+	line := buf.String()
+	if !(strings.HasSuffix(line, "}\n")) || strings.Count(line, "\n") != 1 {
+		t.Fatalf("got %q, want one object on one line", line)
+	}
+	var got map[string]any
+	if err := json.Unmarshal([]byte(line), &got); err != nil {
+		t.Fatalf("%v: %s", err, line)
+	}
+	if _, err := time.Parse(time.RFC3339Nano, got["time"].(string)); err != nil {
+		t.Errorf("time: %v", err)
+	}
+	delete(got, "time")
+	want := map[string]any{
+		"level":   PREFIX_WRN,
+		"msg":     `a "quoted" msg`,
+		"session": "s1",
+		"err":     "boom",
+		"took":    "1.5s",
+		"count":   float64(3),
+	}
+	ch, ok := got["ch"].(string)
+	if !(ok) || !(strings.HasPrefix(ch, "0x")) {
+		t.Errorf("ch: got %#v, want the channel as fmt prints it", got["ch"])
+	}
+	delete(got, "ch")
+	if !(reflect.DeepEqual(got, want)) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	// the fields follow the time, level and msg in the order they were given
+	order := []string{`"time"`, `"level"`, `"msg"`, `"session"`, `"err"`, `"took"`, `"count"`, `"ch"`}
+	for i := 1; i < len(order); i++ {
+		if strings.Index(line, order[i-1]) > strings.Index(line, order[i]) {
+			t.Errorf("%s written before %s: %s", order[i], order[i-1], line)
+		}
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	keys := []string{"LOG_LEVEL", "LOG_FORMAT", "LOG_OUTPUT", "LOG_MAX_SIZE_MB", "LOG_MAX_AGE_DAYS", "LOG_MAX_BACKUPS", "LOG_COMPRESS"}
+	tests := []struct {
+		name string
+		env  map[string]string
+		want *Config
+		err  error
+	}{
+		{"defaults", map[string]string{},
+			&Config{Level: LOG_LEVEL_INFO, Format: FORMAT_TEXT, Sinks: []string{SINK_STDERR}}, nil},
+		{"everything", map[string]string{
+			"LOG_LEVEL":        "Warning",
+			"LOG_FORMAT":       "JSON",
+			"LOG_OUTPUT":       " stderr, /var/log/school.log ,,",
+			"LOG_MAX_SIZE_MB":  "10",
+			"LOG_MAX_AGE_DAYS": "7",
+			"LOG_MAX_BACKUPS":  "3",
+			"LOG_COMPRESS":     "true",
+		}, &Config{
+			Level:  LOG_LEVEL_WRN,
+			Format: FORMAT_JSON,
+			Sinks:  []string{SINK_STDERR, "/var/log/school.log"},
+			Rotate: RotateConfig{MaxSize: 10 << 20, MaxAge: 7 * 24 * time.Hour, MaxBackups: 3, Compress: true},
+		}, nil},
+		{"error level", map[string]string{"LOG_LEVEL": "error"},
+			&Config{Level: LOG_LEVEL_ERR, Format: FORMAT_TEXT, Sinks: []string{SINK_STDERR}}, nil},
+		{"unknown level", map[string]string{"LOG_LEVEL": "debug"}, nil, errInvalidLogLevel},
+		{"negative size", map[string]string{"LOG_MAX_SIZE_MB": "-1"}, nil, errInvalidRotation},
+		{"days in words", map[string]string{"LOG_MAX_AGE_DAYS": "seven"}, nil, errInvalidRotation},
+		{"fractional backups", map[string]string{"LOG_MAX_BACKUPS": "1.5"}, nil, errInvalidRotation},
+		{"compress maybe", map[string]string{"LOG_COMPRESS": "maybe"}, nil, errInvalidRotation},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, key := range keys {
+				t.Setenv(key, test.env[key])
+			}
+			got, err := ConfigFromEnv()
+			if test.err != nil {
+				if !(errors.Is(err, test.err)) {
+					t.Errorf("got %v, want %v", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !(reflect.DeepEqual(got, test.want)) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestConfigureInvalid(t *testing.T) {
+	l, _ := bufferLogger(LOG_LEVEL_INFO, encodeText)
+	if err := l.Configure(&Config{Level: LOG_LEVEL_INFO, Format: "xml"}); !(errors.Is(err, errInvalidLogFormat)) {
+		t.Errorf("got %v, want %v", err, errInvalidLogFormat)
+	}
+	if err := l.Configure(&Config{Level: LOG_LEVEL_FATAL_ERR + 1, Format: FORMAT_TEXT}); !(errors.Is(err, errInvalidLogLevel)) {
+		t.Errorf("got %v, want %v", err, errInvalidLogLevel)
+	}
+}
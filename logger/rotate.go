@@ -0,0 +1,269 @@
+package logger
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const BACKUP_TIME_FORMAT string = "2006-01-02T15-04-05.000"
+
+// RotateConfig limits a log file and its backups. Zero values set no limit.
+//
+// The file is renamed to a backup, timestamped `name-2006-01-02T15-04-05.000.ext`,
+// when a write would take it past MaxSize bytes. A backup rotated in the same
+// millisecond as an existing one gets a sequence number, `name-2006-01-02T15-04-05.000-1.ext`. Backups older than MaxAge and
+// all but the MaxBackups newest are then removed, and the rest gzipped when Compress
+// is set, in the background so writes don't wait on it. Existing backups are pruned
+// the same way when the file is opened.
+type RotateConfig struct {
+	MaxSize    int64
+	MaxAge     time.Duration
+	MaxBackups int
+	Compress   bool
+}
+
+// RotatingFile is a sink appending to a file it rotates as RotateConfig describes.
+type RotatingFile struct {
+	path string
+	cfg  RotateConfig
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+
+	// prunes wakes the goroutine pruning backups, nil once closed.
+	prunes chan struct{}
+	// done is closed when the pruning goroutine returns.
+	done chan struct{}
+	// pruneErr is the last error pruning, read after done is closed.
+	pruneErr error
+}
+
+func NewRotatingFile(path string, cfg RotateConfig) (*RotatingFile, error) {
+	rf := &RotatingFile{
+		path: path,
+		cfg:  cfg,
+	}
+	if err := rf.open(); err != nil {
+		return new(RotatingFile), err
+	}
+	rf.prunes = make(chan struct{}, 1)
+	rf.done = make(chan struct{})
+	go rf.pruneLoop(rf.prunes)
+	rf.prunes <- struct{}{}
+	return rf, nil
+}
+
+func (rf *RotatingFile) Write(p []byte) (int, error) {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	if rf.file == nil {
+		return 0, os.ErrClosed
+	}
+	if rf.cfg.MaxSize > 0 && rf.size > 0 && rf.size+int64(len(p)) > rf.cfg.MaxSize {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := rf.file.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+// Rotate moves the current file to a backup and starts a new one, whatever its size.
+func (rf *RotatingFile) Rotate() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	if rf.file == nil {
+		return os.ErrClosed
+	}
+	return rf.rotate()
+}
+
+// Close closes the file and waits for any pruning under way, returning the
+// last error pruning met.
+func (rf *RotatingFile) Close() error {
+	rf.mu.Lock()
+	if rf.prunes == nil {
+		rf.mu.Unlock()
+		return nil
+	}
+	close(rf.prunes)
+	rf.prunes = nil
+	var err error
+	if rf.file != nil {
+		err = rf.file.Close()
+		rf.file = nil
+	}
+	rf.mu.Unlock()
+	<-rf.done
+	return errors.Join(err, rf.pruneErr)
+}
+
+func (rf *RotatingFile) open() error {
+	f, err := os.OpenFile(rf.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	rf.file, rf.size = f, info.Size()
+	return nil
+}
+
+func (rf *RotatingFile) rotate() error {
+	if err := rf.file.Close(); err != nil {
+		return err
+	}
+	rf.file = nil
+	if err := os.Rename(rf.path, rf.backupName(time.Now())); err != nil {
+		// keep writing to the file rather than losing the sink
+		return errors.Join(err, rf.open())
+	}
+	if err := rf.open(); err != nil {
+		return err
+	}
+	select {
+	case rf.prunes <- struct{}{}:
+	default:
+		// a prune is already pending and will see the new backup
+	}
+	return nil
+}
+
+// backupName is the path the file is renamed to when rotated at t, numbered
+// past the backups of the same millisecond so that none is overwritten.
+func (rf *RotatingFile) backupName(t time.Time) string {
+	ext := filepath.Ext(rf.path)
+	base := strings.TrimSuffix(rf.path, ext) + "-" + t.UTC().Format(BACKUP_TIME_FORMAT)
+	name := base + ext
+	for seq := 1; exists(name) || exists(name+".gz"); seq++ {
+		name = base + "-" + strconv.Itoa(seq) + ext
+	}
+	return name
+}
+
+func exists(path string) bool {
+	_, err := os.Lstat(path)
+	return !(errors.Is(err, fs.ErrNotExist))
+}
+
+type backup struct {
+	path string
+	time time.Time
+	// seq orders the backups of the same millisecond.
+	seq int
+}
+
+// backups lists the backups of the file, newest first.
+func (rf *RotatingFile) backups() ([]*backup, error) {
+	dir := filepath.Dir(rf.path)
+	ext := filepath.Ext(rf.path)
+	prefix := strings.TrimSuffix(filepath.Base(rf.path), ext) + "-"
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return []*backup{}, err
+	}
+	list := []*backup{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !(strings.HasPrefix(name, prefix)) {
+			continue
+		}
+		stamp := strings.TrimSuffix(strings.TrimSuffix(strings.TrimPrefix(name, prefix), ".gz"), ext)
+		if len(stamp) < len(BACKUP_TIME_FORMAT) {
+			continue
+		}
+		t, err := time.Parse(BACKUP_TIME_FORMAT, stamp[:len(BACKUP_TIME_FORMAT)])
+		if err != nil {
+			continue
+		}
+		b := &backup{path: filepath.Join(dir, name), time: t}
+		if suffix := stamp[len(BACKUP_TIME_FORMAT):]; suffix != "" {
+			seq, err := strconv.ParseUint(strings.TrimPrefix(suffix, "-"), 10, 31)
+			if !(strings.HasPrefix(suffix, "-")) || err != nil {
+				continue
+			}
+			b.seq = int(seq)
+		}
+		list = append(list, b)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].time.Equal(list[j].time) {
+			return list[i].seq > list[j].seq
+		}
+		return list[i].time.After(list[j].time)
+	})
+	return list, nil
+}
+
+// pruneLoop prunes the backups each time it is woken, until prunes is closed.
+func (rf *RotatingFile) pruneLoop(prunes <-chan struct{}) {
+	defer close(rf.done)
+	for range prunes {
+		if err := rf.prune(); err != nil {
+			rf.pruneErr = err
+		}
+	}
+}
+
+// prune removes the backups past MaxAge or MaxBackups and compresses the others.
+func (rf *RotatingFile) prune() error {
+	list, err := rf.backups()
+	if err != nil {
+		return err
+	}
+	errs := []error{}
+	for i, b := range list {
+		if (rf.cfg.MaxBackups > 0 && i >= rf.cfg.MaxBackups) || (rf.cfg.MaxAge > 0 && time.Since(b.time) > rf.cfg.MaxAge) {
+			errs = append(errs, os.Remove(b.path))
+			continue
+		}
+		if rf.cfg.Compress && !(strings.HasSuffix(b.path, ".gz")) {
+			errs = append(errs, compress(b.path))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// compress replaces the file at path by path.gz.
+func compress(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(path+".gz", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(dst)
+	if _, err = io.Copy(zw, src); err != nil {
+		dst.Close()
+		os.Remove(path + ".gz")
+		return err
+	}
+	if err = zw.Close(); err != nil {
+		dst.Close()
+		os.Remove(path + ".gz")
+		return err
+	}
+	if err = dst.Close(); err != nil {
+		os.Remove(path + ".gz")
+		return err
+	}
+	src.Close()
+	return os.Remove(path)
+}
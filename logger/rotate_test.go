@@ -0,0 +1,243 @@
+package logger
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestFile(t *testing.T, cfg RotateConfig) (*RotatingFile, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "school.log")
+	rf, err := NewRotatingFile(path, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rf.Close() })
+	return rf, path
+}
+
+func write(t *testing.T, rf *RotatingFile, lines ...string) {
+	t.Helper()
+	for _, line := range lines {
+		if _, err := rf.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// readBackups returns the contents of the backups of rf, newest first, gunzipping the compressed ones.
+func readBackups(t *testing.T, rf *RotatingFile) []string {
+	t.Helper()
+	list, err := rf.backups()
+	if err != nil {
+		t.Fatal(err)
+	}
+	contents := []string{}
+	for _, b := range list {
+		f, err := os.Open(b.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var r io.Reader = f
+		if strings.HasSuffix(b.path, ".gz") {
+			if r, err = gzip.NewReader(f); err != nil {
+				t.Fatal(err)
+			}
+		}
+		data, err := io.ReadAll(r)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		contents = append(contents, string(data))
+	}
+	return contents
+}
+
+func lines(from int, to int) []string {
+	list := []string{}
+	for i := from; i < to; i++ {
+		list = append(list, fmt.Sprintf("%09d\n", i))
+	}
+	return list
+}
+
+func reversed(list []string) []string {
+	r := make([]string, 0, len(list))
+	for i := len(list) - 1; i >= 0; i-- {
+		r = append(r, list[i])
+	}
+	return r
+}
+
+func TestRotateSize(t *testing.T) {
+	rf, path := newTestFile(t, RotateConfig{MaxSize: 25})
+	written := lines(0, 20)
+	// two 10 byte lines fit, the third rotates, many of them within the same millisecond
+	write(t, rf, written...)
+	if err := rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+	got := readBackups(t, rf)
+	want := []string{}
+	for i := len(written) - 2; i > 0; i -= 2 {
+		want = append(want, written[i-2]+written[i-1])
+	}
+	if !(reflect.DeepEqual(got, want)) {
+		t.Errorf("got backups %q, want %q", got, want)
+	}
+	current, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := written[18] + written[19]; string(current) != want {
+		t.Errorf("got file %q, want %q", current, want)
+	}
+}
+
+func TestRotateSameMillisecond(t *testing.T) {
+	rf, _ := newTestFile(t, RotateConfig{})
+	now := time.Now()
+	first := rf.backupName(now)
+	if err := os.WriteFile(first, []byte("a"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	second := rf.backupName(now)
+	if second == first {
+		t.Fatalf("backup name %s reused", second)
+	}
+	if err := os.WriteFile(second+".gz", []byte("b"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	third := rf.backupName(now)
+	if third == first || third == second {
+		t.Fatalf("backup name %s reused", third)
+	}
+	if err := os.WriteFile(third, []byte("c"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	list, err := rf.backups()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []string{}
+	for _, b := range list {
+		got = append(got, b.path)
+	}
+	if want := []string{third, second + ".gz", first}; !(reflect.DeepEqual(got, want)) {
+		t.Errorf("got backups %q, want %q", got, want)
+	}
+}
+
+func TestRotateMaxBackups(t *testing.T) {
+	rf, _ := newTestFile(t, RotateConfig{MaxSize: 10, MaxBackups: 2})
+	write(t, rf, lines(0, 5)...)
+	if err := rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := readBackups(t, rf), reversed(lines(2, 4)); !(reflect.DeepEqual(got, want)) {
+		t.Errorf("got backups %q, want %q", got, want)
+	}
+}
+
+func TestRotateMaxAge(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "school.log")
+	probe := &RotatingFile{path: path}
+	old := probe.backupName(time.Now().Add(-48 * time.Hour))
+	recent := probe.backupName(time.Now().Add(-time.Hour))
+	other := filepath.Join(dir, "school-notes.log")
+	for _, p := range []string{old, recent, other} {
+		if err := os.WriteFile(p, []byte("x\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// backups are pruned as the file is opened
+	rf, err := NewRotatingFile(path, RotateConfig{MaxAge: 24 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(old); !(errors.Is(err, os.ErrNotExist)) {
+		t.Errorf("backup past MaxAge kept: %v", err)
+	}
+	for _, p := range []string{recent, other} {
+		if _, err = os.Stat(p); err != nil {
+			t.Errorf("%s removed: %v", filepath.Base(p), err)
+		}
+	}
+}
+
+func TestRotateCompress(t *testing.T) {
+	rf, path := newTestFile(t, RotateConfig{MaxSize: 10, Compress: true})
+	write(t, rf, lines(0, 3)...)
+	if err := rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+	list, err := rf.backups()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range list {
+		if !(strings.HasSuffix(b.path, ".gz")) {
+			t.Errorf("backup %s not compressed", filepath.Base(b.path))
+		}
+	}
+	if got, want := readBackups(t, rf), reversed(lines(0, 2)); !(reflect.DeepEqual(got, want)) {
+		t.Errorf("got backups %q, want %q", got, want)
+	}
+	current, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := lines(2, 3)[0]; string(current) != want {
+		t.Errorf("got file %q, want %q", current, want)
+	}
+}
+
+func TestRotateClose(t *testing.T) {
+	rf, _ := newTestFile(t, RotateConfig{MaxSize: 10})
+	write(t, rf, "before\n")
+	if err := rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rf.Write([]byte("after\n")); !(errors.Is(err, os.ErrClosed)) {
+		t.Errorf("Write after Close: got %v, want %v", err, os.ErrClosed)
+	}
+	if err := rf.Rotate(); !(errors.Is(err, os.ErrClosed)) {
+		t.Errorf("Rotate after Close: got %v, want %v", err, os.ErrClosed)
+	}
+	if err := rf.Close(); err != nil {
+		t.Errorf("second Close: %v", err)
+	}
+}
+
+func TestRotateRenameFails(t *testing.T) {
+	rf, path := newTestFile(t, RotateConfig{})
+	write(t, rf, "lost\n")
+	// removing the file makes renaming it to a backup fail
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := rf.Rotate(); err == nil {
+		t.Fatal("Rotate of a removed file succeeded")
+	}
+	write(t, rf, "kept\n")
+	current, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(current) != "kept\n" {
+		t.Errorf("got file %q, want %q", current, "kept\n")
+	}
+}
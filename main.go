@@ -1,29 +1,151 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"os"
+	"os/signal"
 
 	"github.com/xHappyface/school/api/ports"
 	"github.com/xHappyface/school/cmd/cli"
+	"github.com/xHappyface/school/cmd/rest"
+	"github.com/xHappyface/school/cmd/rpc"
+	"github.com/xHappyface/school/core/handlers"
 	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/mysql_db"
 
 	"github.com/joho/godotenv"
 )
 
+var errUnknownCommand = errors.New("unknown command")
+
 func main() {
+	script := flag.String("f", "", "run the statements in `file` instead of prompting for them")
+	continueOnError := flag.Bool("continue-on-error", false, "keep running a script after a statement fails")
+	format := flag.String("format", "table", "output `format` of list and show: table, json or csv")
+	flag.Parse()
 	l := logger.New()
+	err := run(l, *script, *continueOnError, *format)
+	if err != nil {
+		l.Log(logger.LOG_LEVEL_FATAL_ERR, err.Error())
+	}
+	// flush and close the log files before exiting, the deferred cleanups of run have already run
+	l.Close()
+	os.Exit(exitCode(err))
+}
+
+// run starts the cli or the server flag.Arg(0) names, closing the database before it returns.
+func run(l *logger.SchoolLogger, script string, continueOnError bool, format string) error {
 	// load environment
 	if err := godotenv.Load(); err != nil {
-		l.Log(logger.LOG_LEVEL_FATAL_ERR, err.Error())
+		return configError(err)
+	}
+	logConfig, err := logger.ConfigFromEnv()
+	if err != nil {
+		return configError(err)
+	}
+	if err = l.Configure(logConfig); err != nil {
+		return configError(err)
+	}
+	backend := os.Getenv("DB_BACKEND")
+	if backend == "" {
+		backend = ports.BACKEND_MYSQL
+	}
+	var source string
+	switch backend {
+	case ports.BACKEND_SQLITE:
+		source = os.Getenv("SQLITE_PATH")
+		if source == "" {
+			source = "school.db"
+		}
+	case ports.BACKEND_POSTGRES:
+		source = os.Getenv("POSTGRES_URL")
+	case ports.BACKEND_MYSQL:
+		source = os.Getenv("MYSQL_DSN")
 	}
 	// logic:
-	school, err := ports.NewSchoolService(l, 10_000)
+	school, err := ports.NewSchoolService(l, backend, source, 10_000)
+	if errors.Is(err, ports.ErrInvalidBackend) || errors.Is(err, mysql_db.ErrInvalidSlowQuery) {
+		return configError(err)
+	} else if err != nil {
+		return connectionError(err)
+	}
+	defer func() {
+		if err := school.DB.Close(); err != nil {
+			l.Log(logger.LOG_LEVEL_ERR, err.Error())
+		}
+	}()
+	switch flag.Arg(0) {
+	case "":
+	case "http":
+		return serveHTTP(l, school, flag.Args()[1:])
+	case "grpc":
+		return serveGRPC(l, school, flag.Args()[1:])
+	default:
+		return configError(fmt.Errorf("%w: %s", errUnknownCommand, flag.Arg(0)))
+	}
+	var input io.Reader = os.Stdin
+	if script != "" {
+		f, err := os.Open(script)
+		if err != nil {
+			return configError(err)
+		}
+		defer f.Close()
+		input = f
+	}
+	cl := cli.NewCLIRepository(input, os.Stdout, l)
+	cl.Script = script != "" || !(isTerminal(os.Stdin))
+	cl.ContinueOnError = continueOnError
+	if err = cl.SetFormat(format); err != nil {
+		return configError(err)
+	}
+	return cl.Run(school)
+}
+
+// serveHTTP runs `school http [-addr host:port]` until interrupted.
+func serveHTTP(l *logger.SchoolLogger, school *ports.SchoolService, args []string) error {
+	fs := flag.NewFlagSet("http", flag.ContinueOnError)
+	addr := fs.String("addr", ":8080", "`address` to listen on")
+	if err := fs.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return nil
+	} else if err != nil {
+		return configError(err)
+	}
+	if err := handlers.CheckSchema(context.Background(), school); err != nil {
+		return err
+	}
+	srv, err := rest.NewServer(school, l)
 	if err != nil {
-		l.Log(logger.LOG_LEVEL_FATAL_ERR, err.Error())
+		return err
 	}
-	defer school.DB.Close()
-	cl := cli.NewCLIRepository(os.Stdin, os.Stdout, l)
-	if err = cl.Run(school); err != nil {
-		l.Log(logger.LOG_LEVEL_FATAL_ERR, err.Error())
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	return srv.ListenAndServe(ctx, *addr)
+}
+
+// serveGRPC runs `school grpc [-addr host:port]` until interrupted.
+func serveGRPC(l *logger.SchoolLogger, school *ports.SchoolService, args []string) error {
+	fs := flag.NewFlagSet("grpc", flag.ContinueOnError)
+	addr := fs.String("addr", ":9090", "`address` to listen on")
+	if err := fs.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return nil
+	} else if err != nil {
+		return configError(err)
 	}
+	if err := handlers.CheckSchema(context.Background(), school); err != nil {
+		return err
+	}
+	srv := rpc.NewServer(school, l)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	return srv.ListenAndServe(ctx, *addr)
+}
+
+// isTerminal tells a person typing at the prompt from statements piped in.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
 }
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/xHappyface/school/api/assignments"
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+func AssignProfessor(ctx context.Context, w io.Writer, sch *ports.SchoolService, professorID string, courseID string, role string) error {
+	if !(assignments.ValidRole(role)) {
+		return ErrInvalidRole
+	}
+	var professor *professors.Professor
+	var course *courses.Course
+	err := sch.InTransaction(ctx, func(uow *ports.UnitOfWork) error {
+		var err error
+		professor, err = uow.ProfessorRepo.ReadByID(ctx, professorID)
+		if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+			return ErrProfessorNotFound
+		} else if err != nil {
+			return err
+		}
+		course, err = uow.CourseRepo.ReadByID(ctx, courseID)
+		if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+			return ErrCourseNotFound
+		} else if err != nil {
+			return err
+		}
+		assigned, err := uow.AssignmentRepo.ListByProfessor(ctx, professor.ID)
+		if err != nil {
+			return err
+		}
+		for _, a := range assigned {
+			if a.CourseID == course.ID {
+				return ErrObjectAlreadyExists
+			}
+		}
+		assignment := &assignments.Assignment{
+			ProfessorID: professor.ID,
+			CourseID:    course.ID,
+			Role:        role,
+		}
+		return uow.AssignmentRepo.Assign(ctx, assignment)
+	})
+	if err != nil {
+		return alreadyExists(err)
+	}
+	fmt.Fprintf(w, "Professor %s assigned to %s as %s.\n", professor.Name, course.Name, role)
+	return nil
+}
+
+func UnassignProfessor(ctx context.Context, w io.Writer, sch *ports.SchoolService, professorID string, courseID string) error {
+	if _, err := sch.ProfessorRepo.ReadByID(ctx, professorID); errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		return ErrProfessorNotFound
+	} else if err != nil {
+		return err
+	}
+	if _, err := sch.CourseRepo.ReadByID(ctx, courseID); errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		return ErrCourseNotFound
+	} else if err != nil {
+		return err
+	}
+	err := sch.AssignmentRepo.Unassign(ctx, professorID, courseID)
+	if errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		return ErrNotAssigned
+	} else if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "Professor unassigned.")
+	return nil
+}
@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/api/students"
+)
+
+// CheckCourse puts the fields of a course built outside of the prompts into the
+// form they are stored in, then validates it as the prompts do.
+func CheckCourse(course *courses.Course) error {
+	course.Name = strings.ToUpper(course.Name)
+	return course.Validate()
+}
+
+// CheckProfessor is CheckCourse for professors.
+func CheckProfessor(professor *professors.Professor) error {
+	professor.Name = normalizeName(professor.Name)
+	professor.Address = strings.Join(strings.Fields(professor.Address), " ")
+	return professor.Validate()
+}
+
+// CheckStudent is CheckCourse for students.
+func CheckStudent(student *students.Student) error {
+	student.Name = normalizeName(student.Name)
+	student.Address = strings.Join(strings.Fields(student.Address), " ")
+	return student.Validate()
+}
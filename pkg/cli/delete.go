@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+// DeleteCourse deletes the course with id once confirmed, by confirmation when it
+// is not empty and by asking otherwise, unless r is nil.
+func DeleteCourse(ctx context.Context, r io.Reader, w io.Writer, repo ports.CourseRepository, id string, confirmation string) error {
+	course, err := repo.ReadByID(ctx, id)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		return ErrCourseNotFound
+	} else if err != nil {
+		return err
+	}
+	if ok, err := confirm(r, w, fmt.Sprintf("delete course %s", course.Name), confirmation); err != nil || !ok {
+		return err
+	}
+	if err = repo.DeleteByID(ctx, course.ID); errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		return ErrCourseNotFound
+	} else if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "Course deleted.")
+	return nil
+}
+
+// DeleteProfessor deletes the professor with id once confirmed, by confirmation when it
+// is not empty and by asking otherwise, unless r is nil.
+func DeleteProfessor(ctx context.Context, r io.Reader, w io.Writer, repo ports.ProfessorRepository, id string, confirmation string) error {
+	professor, err := repo.ReadByID(ctx, id)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		return ErrProfessorNotFound
+	} else if err != nil {
+		return err
+	}
+	if ok, err := confirm(r, w, fmt.Sprintf("delete professor %s", professor.Name), confirmation); err != nil || !ok {
+		return err
+	}
+	if err = repo.DeleteByID(ctx, professor.ID); errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		return ErrProfessorNotFound
+	} else if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "Professor deleted.")
+	return nil
+}
+
+// DeleteStudent deletes the student with id once confirmed, by confirmation when it
+// is not empty and by asking otherwise, unless r is nil.
+func DeleteStudent(ctx context.Context, r io.Reader, w io.Writer, repo ports.StudentRepository, id string, confirmation string) error {
+	student, err := repo.ReadByID(ctx, id)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		return ErrStudentNotFound
+	} else if err != nil {
+		return err
+	}
+	if ok, err := confirm(r, w, fmt.Sprintf("delete student %s", student.Name), confirmation); err != nil || !ok {
+		return err
+	}
+	if err = repo.DeleteByID(ctx, student.ID); errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		return ErrStudentNotFound
+	} else if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "Student deleted.")
+	return nil
+}
+
+// confirm asks a yes/no question and reports whether the user agreed.
+// A non-empty given is the answer, without r to ask on it is required.
+func confirm(r io.Reader, w io.Writer, question string, given string) (bool, error) {
+	text := given
+	if text == "" {
+		if r == nil {
+			return false, ErrUnconfirmed
+		}
+		fmt.Fprintf(w, "Really %s? (y/n): ", question)
+		var err error
+		if text, err = readLine(bufio.NewScanner(r)); err != nil {
+			return false, err
+		}
+	}
+	ok, err := parseYesNo(text)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		fmt.Fprintln(w, "Cancelled.")
+	}
+	return ok, nil
+}
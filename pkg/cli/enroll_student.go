@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/enrollments"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+func EnrollStudent(ctx context.Context, w io.Writer, sch *ports.SchoolService, studentID string, courseID string) error {
+	var student *students.Student
+	var course *courses.Course
+	err := sch.InTransaction(ctx, func(uow *ports.UnitOfWork) error {
+		var err error
+		student, err = uow.StudentRepo.ReadByID(ctx, studentID)
+		if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+			return ErrStudentNotFound
+		} else if err != nil {
+			return err
+		}
+		course, err = uow.CourseRepo.ReadByID(ctx, courseID)
+		if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+			return ErrCourseNotFound
+		} else if err != nil {
+			return err
+		}
+		enrolled, err := uow.EnrollmentRepo.ListCoursesByStudent(ctx, student.ID)
+		if err != nil {
+			return err
+		}
+		for _, c := range enrolled {
+			if c.ID == course.ID {
+				return ErrObjectAlreadyExists
+			}
+		}
+		enrollment := &enrollments.Enrollment{
+			StudentID: student.ID,
+			CourseID:  course.ID,
+		}
+		return uow.EnrollmentRepo.Enroll(ctx, enrollment)
+	})
+	if err != nil {
+		return alreadyExists(err)
+	}
+	fmt.Fprintf(w, "Student %s enrolled in %s.\n", student.Name, course.Name)
+	return nil
+}
+
+func DropStudent(ctx context.Context, w io.Writer, sch *ports.SchoolService, studentID string, courseID string) error {
+	err := sch.EnrollmentRepo.Drop(ctx, studentID, courseID)
+	if errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		return ErrNotEnrolled
+	} else if err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "Student dropped.")
+	return nil
+}
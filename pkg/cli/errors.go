@@ -1,8 +1,39 @@
 package cli
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/xHappyface/school/api/validation"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
 
 var (
-	ErrInvalidName         = errors.New("invalid name")
+	ErrInvalidName         = validation.ErrInvalidName
+	ErrInvalidAge          = validation.ErrInvalidAge
+	ErrInvalidAddress      = validation.ErrInvalidAddress
+	ErrInvalidPhone        = validation.ErrInvalidPhone
+	ErrInvalidSalary       = validation.ErrInvalidSalary
+	ErrInvalidYesNo        = errors.New("invalid answer, expected yes or no")
+	ErrInvalidField        = errors.New("invalid field, expected key=value")
+	ErrUnknownField        = errors.New("unknown field")
+	ErrMissingField        = errors.New("missing field")
+	ErrUnconfirmed         = errors.New("not confirmed, expected yes or no after the id")
+	ErrInvalidLimit        = errors.New("invalid limit, expected a positive number")
+	ErrInvalidFormat       = errors.New("invalid format, expected table, json or csv")
 	ErrObjectAlreadyExists = errors.New("object already exists")
+	ErrInvalidRole         = errors.New("invalid role, expected lead or assistant")
+	ErrStudentNotFound     = errors.New("student not found")
+	ErrProfessorNotFound   = errors.New("professor not found")
+	ErrCourseNotFound      = errors.New("course not found")
+	ErrNotEnrolled         = errors.New("student is not enrolled in course")
+	ErrNotAssigned         = errors.New("professor is not assigned to course")
 )
+
+// alreadyExists turns the duplicate key a backend refused, when a concurrent
+// statement won the race between the check and the write, into ErrObjectAlreadyExists.
+func alreadyExists(err error) error {
+	if errors.Is(err, mysql_db.ErrDuplicateKey) {
+		return ErrObjectAlreadyExists
+	}
+	return err
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseFields reads `key=value` arguments into a map, rejecting keys not in allowed.
+// Keys are case insensitive, values are kept as typed.
+func ParseFields(args []string, allowed ...string) (map[string]string, error) {
+	fields := make(map[string]string, len(args))
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		key = strings.ToLower(key)
+		if !ok || key == "" {
+			return map[string]string{}, ErrInvalidField
+		}
+		known := false
+		for _, a := range allowed {
+			known = known || a == key
+		}
+		if !known {
+			return map[string]string{}, fmt.Errorf("%w: %s", ErrUnknownField, key)
+		}
+		fields[key] = value
+	}
+	return fields, nil
+}
+
+// requireFields fails with the keys of the required fields that fields lacks.
+func requireFields(fields map[string]string, required ...string) error {
+	missing := []string{}
+	for _, key := range required {
+		if _, ok := fields[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrMissingField, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func fieldAge(fields map[string]string, key string) (uint8, error) {
+	value, ok := fields[key]
+	if !ok {
+		return 0, nil
+	}
+	return parseAge(value)
+}
+
+func fieldSalary(fields map[string]string, key string) (*float64, error) {
+	value, ok := fields[key]
+	if !ok {
+		return nil, nil
+	}
+	salary, err := parseSalary(value)
+	if err != nil {
+		return nil, err
+	}
+	return &salary, nil
+}
+
+func fieldYesNo(fields map[string]string, key string) (*bool, error) {
+	value, ok := fields[key]
+	if !ok {
+		return nil, nil
+	}
+	b, err := parseYesNo(value)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func fieldLimit(fields map[string]string) (uint, error) {
+	value, ok := fields["limit"]
+	if !ok {
+		return 0, nil
+	}
+	limit, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || limit == 0 {
+		return 0, ErrInvalidLimit
+	}
+	return uint(limit), nil
+}
@@ -0,0 +1,214 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/api/students"
+)
+
+// The fields each list takes as `key=value` args.
+var (
+	CourseListFields    = []string{"name", "sort", "limit"}
+	ProfessorListFields = []string{"name", "min_age", "max_age", "min_salary", "max_salary", "bonus", "sort", "limit"}
+	StudentListFields   = []string{"name", "min_age", "max_age", "international", "probation", "sort", "limit"}
+)
+
+// ListCourses pages through courses, args are `name=<prefix> sort=name limit=<n>`.
+// The table format asks before each next page, json and csv render every page at once.
+func ListCourses(ctx context.Context, r io.Reader, w io.Writer, repo ports.CourseRepository, args []string, format string) error {
+	filter, page, err := CoursesQuery(args)
+	if err != nil {
+		return err
+	}
+	scanner := pager(r)
+	if format != FORMAT_TABLE {
+		scanner = nil
+	}
+	all := []*courses.Course{}
+	for {
+		list, next, err := repo.List(ctx, filter, page)
+		if err != nil {
+			return err
+		}
+		if format == FORMAT_TABLE {
+			if err = render(w, format, coursesTable(list)); err != nil {
+				return err
+			}
+		} else {
+			all = append(all, list...)
+		}
+		if next == "" || !(more(scanner, w)) {
+			break
+		}
+		page.Cursor = next
+	}
+	if format == FORMAT_TABLE {
+		return nil
+	}
+	return render(w, format, coursesTable(all))
+}
+
+// ListProfessors pages through professors, args are `name=<prefix> min_age=<n> max_age=<n>
+// min_salary=<n> max_salary=<n> bonus=<y/n> sort=name|age|salary limit=<n>`. Paged like ListCourses.
+func ListProfessors(ctx context.Context, r io.Reader, w io.Writer, repo ports.ProfessorRepository, args []string, format string) error {
+	filter, page, err := ProfessorsQuery(args)
+	if err != nil {
+		return err
+	}
+	scanner := pager(r)
+	if format != FORMAT_TABLE {
+		scanner = nil
+	}
+	all := []*professors.Professor{}
+	for {
+		list, next, err := repo.List(ctx, filter, page)
+		if err != nil {
+			return err
+		}
+		if format == FORMAT_TABLE {
+			if err = render(w, format, professorsTable(list)); err != nil {
+				return err
+			}
+		} else {
+			all = append(all, list...)
+		}
+		if next == "" || !(more(scanner, w)) {
+			break
+		}
+		page.Cursor = next
+	}
+	if format == FORMAT_TABLE {
+		return nil
+	}
+	return render(w, format, professorsTable(all))
+}
+
+// ListStudents pages through students, args are `name=<prefix> min_age=<n> max_age=<n>
+// international=<y/n> probation=<y/n> sort=name|age limit=<n>`. Paged like ListCourses.
+func ListStudents(ctx context.Context, r io.Reader, w io.Writer, repo ports.StudentRepository, args []string, format string) error {
+	filter, page, err := StudentsQuery(args)
+	if err != nil {
+		return err
+	}
+	scanner := pager(r)
+	if format != FORMAT_TABLE {
+		scanner = nil
+	}
+	all := []*students.Student{}
+	for {
+		list, next, err := repo.List(ctx, filter, page)
+		if err != nil {
+			return err
+		}
+		if format == FORMAT_TABLE {
+			if err = render(w, format, studentsTable(list)); err != nil {
+				return err
+			}
+		} else {
+			all = append(all, list...)
+		}
+		if next == "" || !(more(scanner, w)) {
+			break
+		}
+		page.Cursor = next
+	}
+	if format == FORMAT_TABLE {
+		return nil
+	}
+	return render(w, format, studentsTable(all))
+}
+
+// pager returns the scanner more reads answers from, a nil r pages through everything without asking.
+func pager(r io.Reader) *bufio.Scanner {
+	if r == nil {
+		return nil
+	}
+	return bufio.NewScanner(r)
+}
+
+// more asks whether to show the next page; an empty answer means yes.
+func more(scanner *bufio.Scanner, w io.Writer) bool {
+	if scanner == nil {
+		return true
+	}
+	fmt.Fprint(w, "-- more (enter for next page, q to stop): ")
+	text, err := readLine(scanner)
+	return err == nil && text == ""
+}
+
+// CoursesQuery reads the filter and first page of a list of courses from the `key=value` args ListCourses takes.
+func CoursesQuery(args []string) (*courses.Filter, *pagination.Page, error) {
+	fields, err := ParseFields(args, CourseListFields...)
+	if err != nil {
+		return new(courses.Filter), new(pagination.Page), err
+	}
+	filter := &courses.Filter{NamePrefix: normalizeName(fields["name"])}
+	limit, err := fieldLimit(fields)
+	if err != nil {
+		return new(courses.Filter), new(pagination.Page), err
+	}
+	return filter, pagination.NewPage(strings.ToLower(fields["sort"]), "", limit), nil
+}
+
+// ProfessorsQuery reads the filter and first page of a list of professors from the `key=value` args ListProfessors takes.
+func ProfessorsQuery(args []string) (*professors.Filter, *pagination.Page, error) {
+	fields, err := ParseFields(args, ProfessorListFields...)
+	if err != nil {
+		return new(professors.Filter), new(pagination.Page), err
+	}
+	filter := &professors.Filter{NamePrefix: normalizeName(fields["name"])}
+	if filter.MinAge, err = fieldAge(fields, "min_age"); err != nil {
+		return new(professors.Filter), new(pagination.Page), err
+	}
+	if filter.MaxAge, err = fieldAge(fields, "max_age"); err != nil {
+		return new(professors.Filter), new(pagination.Page), err
+	}
+	if filter.MinSalary, err = fieldSalary(fields, "min_salary"); err != nil {
+		return new(professors.Filter), new(pagination.Page), err
+	}
+	if filter.MaxSalary, err = fieldSalary(fields, "max_salary"); err != nil {
+		return new(professors.Filter), new(pagination.Page), err
+	}
+	if filter.IfReceivedBonus, err = fieldYesNo(fields, "bonus"); err != nil {
+		return new(professors.Filter), new(pagination.Page), err
+	}
+	limit, err := fieldLimit(fields)
+	if err != nil {
+		return new(professors.Filter), new(pagination.Page), err
+	}
+	return filter, pagination.NewPage(strings.ToLower(fields["sort"]), "", limit), nil
+}
+
+// StudentsQuery reads the filter and first page of a list of students from the `key=value` args ListStudents takes.
+func StudentsQuery(args []string) (*students.Filter, *pagination.Page, error) {
+	fields, err := ParseFields(args, StudentListFields...)
+	if err != nil {
+		return new(students.Filter), new(pagination.Page), err
+	}
+	filter := &students.Filter{NamePrefix: normalizeName(fields["name"])}
+	if filter.MinAge, err = fieldAge(fields, "min_age"); err != nil {
+		return new(students.Filter), new(pagination.Page), err
+	}
+	if filter.MaxAge, err = fieldAge(fields, "max_age"); err != nil {
+		return new(students.Filter), new(pagination.Page), err
+	}
+	if filter.IfInternational, err = fieldYesNo(fields, "international"); err != nil {
+		return new(students.Filter), new(pagination.Page), err
+	}
+	if filter.IfOnProbation, err = fieldYesNo(fields, "probation"); err != nil {
+		return new(students.Filter), new(pagination.Page), err
+	}
+	limit, err := fieldLimit(fields)
+	if err != nil {
+		return new(students.Filter), new(pagination.Page), err
+	}
+	return filter, pagination.NewPage(strings.ToLower(fields["sort"]), "", limit), nil
+}
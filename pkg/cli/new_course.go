@@ -1,12 +1,10 @@
 package cli
 
 import (
-	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"io"
-	"regexp"
-	"strings"
 
 	"github.com/google/uuid"
 	"github.com/xHappyface/school/api/courses"
@@ -14,37 +12,54 @@ import (
 	"github.com/xHappyface/school/pkg/mysql_db"
 )
 
-func NewCourse(r io.Reader, w io.Writer, repo ports.CourseRepository) error {
-	cfg, err := getCourseConfig(r, w)
+// NewCourse creates a course, prompting for its name unless args give it as `name=<name>`.
+// With a nil r the name must be given.
+func NewCourse(ctx context.Context, r io.Reader, w io.Writer, sch *ports.SchoolService, args []string) error {
+	keys := []string{"name"}
+	fields, err := ParseFields(args, keys...)
 	if err != nil {
 		return err
 	}
-	if _, err = repo.ReadByID(cfg.ID); !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
-		fmt.Fprintln(w, err)
-		return ErrObjectAlreadyExists
+	if r == nil {
+		if err = requireFields(fields, keys...); err != nil {
+			return err
+		}
 	}
-	if _, err = repo.ReadByName(cfg.Name); !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
-		fmt.Fprintln(w, err)
-		return ErrObjectAlreadyExists
+	cfg, err := getCourseConfig(r, w, fields)
+	if err != nil {
+		return err
 	}
-	fmt.Fprintln(w, "New course created.", cfg)
+	if err = CreateCourse(ctx, sch, cfg); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "New course created:", cfg.ID)
 	return nil
 }
 
-func getCourseConfig(r io.Reader, w io.Writer) (*courses.Course, error) {
-	scanner := bufio.NewScanner(r)
-	fmt.Fprint(w, "Enter course name: ")
-	scanner.Scan()
-	if err := scanner.Err(); err != nil {
-		return new(courses.Course), err
+// CreateCourse validates and stores cfg unless another course already has its name.
+func CreateCourse(ctx context.Context, sch *ports.SchoolService, cfg *courses.Course) error {
+	if err := cfg.Validate(); err != nil {
+		return err
 	}
-	name := strings.ToUpper(scanner.Text())
-	match, err := regexp.MatchString(`^\b[ A-Z0-9]+\b$`, name)
+	err := sch.InTransaction(ctx, func(uow *ports.UnitOfWork) error {
+		if _, err := uow.CourseRepo.ReadByName(ctx, cfg.Name); err == nil {
+			return ErrObjectAlreadyExists
+		} else if !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+			return err
+		}
+		return uow.CourseRepo.Create(ctx, cfg)
+	})
+	return alreadyExists(err)
+}
+
+func getCourseConfig(r io.Reader, w io.Writer, fields map[string]string) (*courses.Course, error) {
+	text, err := answer(newScanner(r), w, fields, "name", "course name")
 	if err != nil {
 		return new(courses.Course), err
 	}
-	if !match {
-		return new(courses.Course), ErrInvalidName
+	name, err := parseCourseName(text)
+	if err != nil {
+		return new(courses.Course), err
 	}
 	course := &courses.Course{
 		ID:   uuid.NewString(),
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+// NewProfessor creates a professor, prompting for each field args do not give as
+// `name=<name> age=<age> address=<address> phone=<phone> salary=<salary> bonus=<y/n>`.
+// With a nil r every field must be given.
+func NewProfessor(ctx context.Context, r io.Reader, w io.Writer, sch *ports.SchoolService, args []string) error {
+	keys := []string{"name", "age", "address", "phone", "salary", "bonus"}
+	fields, err := ParseFields(args, keys...)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		if err = requireFields(fields, keys...); err != nil {
+			return err
+		}
+	}
+	cfg, err := getProfessorConfig(r, w, fields)
+	if err != nil {
+		return err
+	}
+	if err = CreateProfessor(ctx, sch, cfg); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "New professor created:", cfg.ID)
+	return nil
+}
+
+// CreateProfessor validates and stores cfg unless another professor already has its name.
+func CreateProfessor(ctx context.Context, sch *ports.SchoolService, cfg *professors.Professor) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	err := sch.InTransaction(ctx, func(uow *ports.UnitOfWork) error {
+		if _, err := uow.ProfessorRepo.ReadByName(ctx, cfg.Name); err == nil {
+			return ErrObjectAlreadyExists
+		} else if !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+			return err
+		}
+		return uow.ProfessorRepo.Create(ctx, cfg)
+	})
+	return alreadyExists(err)
+}
+
+func getProfessorConfig(r io.Reader, w io.Writer, fields map[string]string) (*professors.Professor, error) {
+	scanner := newScanner(r)
+	professor := &professors.Professor{ID: uuid.NewString()}
+	text, err := answer(scanner, w, fields, "name", "professor name")
+	if err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.Name, err = parsePersonName(text); err != nil {
+		return new(professors.Professor), err
+	}
+	if text, err = answer(scanner, w, fields, "age", "age"); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.Age, err = parseAge(text); err != nil {
+		return new(professors.Professor), err
+	}
+	if text, err = answer(scanner, w, fields, "address", "address"); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.Address, err = parseAddress(text); err != nil {
+		return new(professors.Professor), err
+	}
+	if text, err = answer(scanner, w, fields, "phone", "phone"); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.Phone, err = parsePhone(text); err != nil {
+		return new(professors.Professor), err
+	}
+	if text, err = answer(scanner, w, fields, "salary", "salary"); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.Salary, err = parseSalary(text); err != nil {
+		return new(professors.Professor), err
+	}
+	if text, err = answer(scanner, w, fields, "bonus", "if received bonus (y/n)"); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.IfReceivedBonus, err = parseYesNo(text); err != nil {
+		return new(professors.Professor), err
+	}
+	return professor, nil
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+// NewStudent creates a student, prompting for each field args do not give as
+// `name=<name> age=<age> address=<address> phone=<phone> international=<y/n> probation=<y/n>`.
+// With a nil r every field must be given.
+func NewStudent(ctx context.Context, r io.Reader, w io.Writer, sch *ports.SchoolService, args []string) error {
+	keys := []string{"name", "age", "address", "phone", "international", "probation"}
+	fields, err := ParseFields(args, keys...)
+	if err != nil {
+		return err
+	}
+	if r == nil {
+		if err = requireFields(fields, keys...); err != nil {
+			return err
+		}
+	}
+	cfg, err := getStudentConfig(r, w, fields)
+	if err != nil {
+		return err
+	}
+	if err = CreateStudent(ctx, sch, cfg); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "New student created:", cfg.ID)
+	return nil
+}
+
+// CreateStudent validates and stores cfg unless another student already has its name.
+func CreateStudent(ctx context.Context, sch *ports.SchoolService, cfg *students.Student) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	err := sch.InTransaction(ctx, func(uow *ports.UnitOfWork) error {
+		if _, err := uow.StudentRepo.ReadByName(ctx, cfg.Name); err == nil {
+			return ErrObjectAlreadyExists
+		} else if !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+			return err
+		}
+		return uow.StudentRepo.Create(ctx, cfg)
+	})
+	return alreadyExists(err)
+}
+
+func getStudentConfig(r io.Reader, w io.Writer, fields map[string]string) (*students.Student, error) {
+	scanner := newScanner(r)
+	student := &students.Student{ID: uuid.NewString()}
+	text, err := answer(scanner, w, fields, "name", "student name")
+	if err != nil {
+		return new(students.Student), err
+	}
+	if student.Name, err = parsePersonName(text); err != nil {
+		return new(students.Student), err
+	}
+	if text, err = answer(scanner, w, fields, "age", "age"); err != nil {
+		return new(students.Student), err
+	}
+	if student.Age, err = parseAge(text); err != nil {
+		return new(students.Student), err
+	}
+	if text, err = answer(scanner, w, fields, "address", "address"); err != nil {
+		return new(students.Student), err
+	}
+	if student.Address, err = parseAddress(text); err != nil {
+		return new(students.Student), err
+	}
+	if text, err = answer(scanner, w, fields, "phone", "phone"); err != nil {
+		return new(students.Student), err
+	}
+	if student.Phone, err = parsePhone(text); err != nil {
+		return new(students.Student), err
+	}
+	if text, err = answer(scanner, w, fields, "international", "if international (y/n)"); err != nil {
+		return new(students.Student), err
+	}
+	if student.IfInternational, err = parseYesNo(text); err != nil {
+		return new(students.Student), err
+	}
+	if text, err = answer(scanner, w, fields, "probation", "if on probation (y/n)"); err != nil {
+		return new(students.Student), err
+	}
+	if student.IfOnProbation, err = parseYesNo(text); err != nil {
+		return new(students.Student), err
+	}
+	return student, nil
+}
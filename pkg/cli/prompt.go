@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/xHappyface/school/api/validation"
+)
+
+var phonePattern = regexp.MustCompile(`^[0-9]{7,15}$`)
+
+// prompt writes label to w and returns the next trimmed line read by scanner.
+func prompt(scanner *bufio.Scanner, w io.Writer, label string) (string, error) {
+	fmt.Fprintf(w, "Enter %s: ", label)
+	return readLine(scanner)
+}
+
+// answer returns the value given for key in fields, prompting for it with label when there is none.
+// Without a scanner to prompt with a field not given is an ErrMissingField.
+func answer(scanner *bufio.Scanner, w io.Writer, fields map[string]string, key string, label string) (string, error) {
+	if value, ok := fields[key]; ok {
+		return value, nil
+	}
+	if scanner == nil {
+		return "", fmt.Errorf("%w: %s", ErrMissingField, key)
+	}
+	return prompt(scanner, w, label)
+}
+
+// newScanner returns a scanner over r, nil when r is nil and nothing answers prompts.
+func newScanner(r io.Reader) *bufio.Scanner {
+	if r == nil {
+		return nil
+	}
+	return bufio.NewScanner(r)
+}
+
+func readLine(scanner *bufio.Scanner) (string, error) {
+	if !(scanner.Scan()) {
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", io.ErrUnexpectedEOF
+	}
+	return strings.TrimSpace(scanner.Text()), nil
+}
+
+// promptDefault is prompt with def shown in brackets and returned for an empty answer.
+func promptDefault(scanner *bufio.Scanner, w io.Writer, label string, def string) (string, error) {
+	text, err := prompt(scanner, w, fmt.Sprintf("%s [%s]", label, def))
+	if err != nil {
+		return "", err
+	}
+	if text == "" {
+		return def, nil
+	}
+	return text, nil
+}
+
+// answerDefault returns the value given for key in fields, prompting for it like
+// promptDefault when there is none, and def without a scanner to prompt with.
+func answerDefault(scanner *bufio.Scanner, w io.Writer, fields map[string]string, key string, label string, def string) (string, error) {
+	if value, ok := fields[key]; ok {
+		return value, nil
+	}
+	if scanner == nil {
+		return def, nil
+	}
+	return promptDefault(scanner, w, label, def)
+}
+
+// normalizeName puts a name typed as a lookup key into the form names are stored in.
+func normalizeName(s string) string {
+	return strings.Join(strings.Fields(strings.ToUpper(s)), " ")
+}
+
+func formatYesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func parseCourseName(s string) (string, error) {
+	name := strings.ToUpper(s)
+	if err := validation.CourseName(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func parsePersonName(s string) (string, error) {
+	name := normalizeName(s)
+	if err := validation.PersonName(name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+func parseAge(s string) (uint8, error) {
+	age, err := strconv.ParseUint(s, 10, 8)
+	if err != nil || age == 0 {
+		return 0, ErrInvalidAge
+	}
+	return uint8(age), nil
+}
+
+func parseAddress(s string) (string, error) {
+	address := strings.Join(strings.Fields(s), " ")
+	if err := validation.Address(address); err != nil {
+		return "", err
+	}
+	return address, nil
+}
+
+// parsePhone accepts digits optionally separated by spaces, dashes, dots or parentheses.
+func parsePhone(s string) (uint, error) {
+	digits := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')', '+':
+			return -1
+		}
+		return r
+	}, s)
+	if !(phonePattern.MatchString(digits)) {
+		return 0, ErrInvalidPhone
+	}
+	phone, err := strconv.ParseUint(digits, 10, 63)
+	if err != nil {
+		return 0, ErrInvalidPhone
+	}
+	if err = validation.Phone(uint(phone)); err != nil {
+		return 0, err
+	}
+	return uint(phone), nil
+}
+
+func parseSalary(s string) (float64, error) {
+	salary, err := strconv.ParseFloat(strings.TrimPrefix(s, "$"), 64)
+	if err != nil {
+		return 0, ErrInvalidSalary
+	}
+	if err = validation.Salary(salary); err != nil {
+		return 0, err
+	}
+	return salary, nil
+}
+
+func parseYesNo(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "y", "yes", "true":
+		return true, nil
+	case "n", "no", "false":
+		return false, nil
+	default:
+		return false, ErrInvalidYesNo
+	}
+}
@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+const (
+	FORMAT_TABLE string = "table"
+	FORMAT_JSON  string = "json"
+	FORMAT_CSV   string = "csv"
+)
+
+// ParseFormat checks s names an output format.
+func ParseFormat(s string) (string, error) {
+	format := strings.ToLower(s)
+	switch format {
+	case FORMAT_TABLE, FORMAT_JSON, FORMAT_CSV:
+		return format, nil
+	default:
+		return "", ErrInvalidFormat
+	}
+}
+
+// table is a list of objects ready to render, as rows under header
+// for the table and csv formats and as records for json.
+type table struct {
+	header  []string
+	rows    [][]string
+	records []any
+}
+
+func coursesTable(list []*courses.Course) *table {
+	t := &table{header: []string{"id", "name"}}
+	for _, course := range list {
+		t.rows = append(t.rows, []string{course.ID, course.Name})
+		t.records = append(t.records, course)
+	}
+	return t
+}
+
+func professorsTable(list []*professors.Professor) *table {
+	t := &table{header: []string{"id", "name", "age", "address", "phone", "salary", "bonus"}}
+	for _, p := range list {
+		t.rows = append(t.rows, []string{
+			p.ID,
+			p.Name,
+			strconv.FormatUint(uint64(p.Age), 10),
+			p.Address,
+			strconv.FormatUint(uint64(p.Phone), 10),
+			strconv.FormatFloat(p.Salary, 'f', 2, 64),
+			formatYesNo(p.IfReceivedBonus),
+		})
+		t.records = append(t.records, p)
+	}
+	return t
+}
+
+func studentsTable(list []*students.Student) *table {
+	t := &table{header: []string{"id", "name", "age", "address", "phone", "international", "probation"}}
+	for _, s := range list {
+		t.rows = append(t.rows, []string{
+			s.ID,
+			s.Name,
+			strconv.FormatUint(uint64(s.Age), 10),
+			s.Address,
+			strconv.FormatUint(uint64(s.Phone), 10),
+			formatYesNo(s.IfInternational),
+			formatYesNo(s.IfOnProbation),
+		})
+		t.records = append(t.records, s)
+	}
+	return t
+}
+
+// migrationRecord is the json form of a mysql_db.MigrationStatus, without a time while pending.
+type migrationRecord struct {
+	Version   uint       `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+}
+
+func migrationsTable(statuses []*mysql_db.MigrationStatus) *table {
+	t := &table{header: []string{"version", "name", "applied", "applied_at"}}
+	for _, status := range statuses {
+		record := &migrationRecord{Version: status.Version, Name: status.Name, Applied: status.Applied}
+		at := ""
+		if status.Applied {
+			record.AppliedAt = &status.AppliedAt
+			at = status.AppliedAt.Format(time.DateTime)
+		}
+		t.rows = append(t.rows, []string{
+			fmt.Sprintf("%04d", status.Version),
+			status.Name,
+			formatYesNo(status.Applied),
+			at,
+		})
+		t.records = append(t.records, record)
+	}
+	return t
+}
+
+// RenderMigrations writes the status of each migration to w in format.
+func RenderMigrations(w io.Writer, format string, statuses []*mysql_db.MigrationStatus) error {
+	return render(w, format, migrationsTable(statuses))
+}
+
+// render writes t to w in format, json as an array of records.
+func render(w io.Writer, format string, t *table) error {
+	switch format {
+	case FORMAT_JSON:
+		records := t.records
+		if records == nil {
+			records = []any{}
+		}
+		return writeJSON(w, records)
+	case FORMAT_CSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(t.header); err != nil {
+			return err
+		}
+		if err := cw.WriteAll(t.rows); err != nil {
+			return err
+		}
+		return cw.Error()
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(t.header, "\t")))
+		for _, row := range t.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+}
+
+// renderOne writes the single object in t, json as a lone record rather than an array.
+func renderOne(w io.Writer, format string, t *table) error {
+	if format == FORMAT_JSON && len(t.records) == 1 {
+		return writeJSON(w, t.records[0])
+	}
+	return render(w, format, t)
+}
+
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+// ShowCourse prints the course whose id or name is key, with its professors and students.
+// The json and csv formats render only the course's own fields, the same goes for professors and students.
+func ShowCourse(ctx context.Context, w io.Writer, sch *ports.SchoolService, key string, format string) error {
+	course, err := findCourse(ctx, sch.CourseRepo, key)
+	if err != nil {
+		return err
+	}
+	if format != FORMAT_TABLE {
+		return renderOne(w, format, coursesTable([]*courses.Course{course}))
+	}
+	assigned, err := sch.AssignmentRepo.ListByCourse(ctx, course.ID)
+	if err != nil {
+		return err
+	}
+	enrolled, err := sch.EnrollmentRepo.ListStudentsByCourse(ctx, course.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "id:         %s\n", course.ID)
+	fmt.Fprintf(w, "name:       %s\n", course.Name)
+	for _, a := range assigned {
+		professor, err := sch.ProfessorRepo.ReadByID(ctx, a.ProfessorID)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "professor:  %s (%s)\n", professor.Name, a.Role)
+	}
+	fmt.Fprintf(w, "students:   %d\n", len(enrolled))
+	for _, student := range enrolled {
+		fmt.Fprintf(w, "  %s %s\n", student.ID, student.Name)
+	}
+	return nil
+}
+
+// ShowProfessor prints the professor whose id or name is key, with the courses they are assigned to.
+func ShowProfessor(ctx context.Context, w io.Writer, sch *ports.SchoolService, key string, format string) error {
+	professor, err := findProfessor(ctx, sch.ProfessorRepo, key)
+	if err != nil {
+		return err
+	}
+	if format != FORMAT_TABLE {
+		return renderOne(w, format, professorsTable([]*professors.Professor{professor}))
+	}
+	assigned, err := sch.AssignmentRepo.ListByProfessor(ctx, professor.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "id:         %s\n", professor.ID)
+	fmt.Fprintf(w, "name:       %s\n", professor.Name)
+	fmt.Fprintf(w, "age:        %d\n", professor.Age)
+	fmt.Fprintf(w, "address:    %s\n", professor.Address)
+	fmt.Fprintf(w, "phone:      %d\n", professor.Phone)
+	fmt.Fprintf(w, "salary:     %.2f\n", professor.Salary)
+	fmt.Fprintf(w, "bonus:      %s\n", formatYesNo(professor.IfReceivedBonus))
+	fmt.Fprintf(w, "courses:    %d\n", len(assigned))
+	for _, a := range assigned {
+		course, err := sch.CourseRepo.ReadByID(ctx, a.CourseID)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "  %s %s (%s)\n", course.ID, course.Name, a.Role)
+	}
+	return nil
+}
+
+// ShowStudent prints the student whose id or name is key, with the courses they are enrolled in.
+func ShowStudent(ctx context.Context, w io.Writer, sch *ports.SchoolService, key string, format string) error {
+	student, err := findStudent(ctx, sch.StudentRepo, key)
+	if err != nil {
+		return err
+	}
+	if format != FORMAT_TABLE {
+		return renderOne(w, format, studentsTable([]*students.Student{student}))
+	}
+	enrolled, err := sch.EnrollmentRepo.ListCoursesByStudent(ctx, student.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "id:            %s\n", student.ID)
+	fmt.Fprintf(w, "name:          %s\n", student.Name)
+	fmt.Fprintf(w, "age:           %d\n", student.Age)
+	fmt.Fprintf(w, "address:       %s\n", student.Address)
+	fmt.Fprintf(w, "phone:         %d\n", student.Phone)
+	fmt.Fprintf(w, "international: %s\n", formatYesNo(student.IfInternational))
+	fmt.Fprintf(w, "probation:     %s\n", formatYesNo(student.IfOnProbation))
+	fmt.Fprintf(w, "courses:       %d\n", len(enrolled))
+	for _, course := range enrolled {
+		fmt.Fprintf(w, "  %s %s\n", course.ID, course.Name)
+	}
+	return nil
+}
+
+func findCourse(ctx context.Context, repo ports.CourseRepository, key string) (*courses.Course, error) {
+	course, err := repo.ReadByID(ctx, key)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		course, err = repo.ReadByName(ctx, normalizeName(key))
+	}
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		return new(courses.Course), ErrCourseNotFound
+	}
+	return course, err
+}
+
+func findProfessor(ctx context.Context, repo ports.ProfessorRepository, key string) (*professors.Professor, error) {
+	professor, err := repo.ReadByID(ctx, key)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		professor, err = repo.ReadByName(ctx, normalizeName(key))
+	}
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		return new(professors.Professor), ErrProfessorNotFound
+	}
+	return professor, err
+}
+
+func findStudent(ctx context.Context, repo ports.StudentRepository, key string) (*students.Student, error) {
+	student, err := repo.ReadByID(ctx, key)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		student, err = repo.ReadByName(ctx, normalizeName(key))
+	}
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		return new(students.Student), ErrStudentNotFound
+	}
+	return student, err
+}
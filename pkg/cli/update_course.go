@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+// UpdateCourse sets the fields of the course with id that args give as key=value, as NewCourse
+// takes them. When args give none it prompts for each field, unless r is nil.
+func UpdateCourse(ctx context.Context, r io.Reader, w io.Writer, sch *ports.SchoolService, id string, args []string) error {
+	fields, err := ParseFields(args, "name")
+	if err != nil {
+		return err
+	}
+	if len(fields) > 0 {
+		r = nil
+	}
+	course, err := sch.CourseRepo.ReadByID(ctx, id)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		return ErrCourseNotFound
+	} else if err != nil {
+		return err
+	}
+	cfg, err := getCourseUpdate(r, w, fields, course)
+	if err != nil {
+		return err
+	}
+	if *cfg == *course {
+		fmt.Fprintln(w, "Nothing to update.")
+		return nil
+	}
+	if err = SaveCourse(ctx, sch, cfg); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "Course updated.")
+	return nil
+}
+
+// SaveCourse validates cfg and replaces the course with cfg's id by cfg, unless another course already has its name.
+func SaveCourse(ctx context.Context, sch *ports.SchoolService, cfg *courses.Course) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	err := sch.InTransaction(ctx, func(uow *ports.UnitOfWork) error {
+		current, err := uow.CourseRepo.ReadByID(ctx, cfg.ID)
+		if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+			return ErrCourseNotFound
+		} else if err != nil {
+			return err
+		}
+		if *current == *cfg {
+			return nil
+		}
+		if cfg.Name != current.Name {
+			if _, err := uow.CourseRepo.ReadByName(ctx, cfg.Name); err == nil {
+				return ErrObjectAlreadyExists
+			} else if !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+				return err
+			}
+		}
+		return uow.CourseRepo.Update(ctx, cfg)
+	})
+	if errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		return ErrCourseNotFound
+	}
+	return alreadyExists(err)
+}
+
+func getCourseUpdate(r io.Reader, w io.Writer, fields map[string]string, current *courses.Course) (*courses.Course, error) {
+	scanner := newScanner(r)
+	course := *current
+	text, err := answerDefault(scanner, w, fields, "name", "course name", current.Name)
+	if err != nil {
+		return new(courses.Course), err
+	}
+	if course.Name, err = parseCourseName(text); err != nil {
+		return new(courses.Course), err
+	}
+	return &course, nil
+}
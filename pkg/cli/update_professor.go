@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+// UpdateProfessor sets the fields of the professor with id that args give as key=value, as NewProfessor
+// takes them. When args give none it prompts for each field, unless r is nil.
+func UpdateProfessor(ctx context.Context, r io.Reader, w io.Writer, sch *ports.SchoolService, id string, args []string) error {
+	fields, err := ParseFields(args, "name", "age", "address", "phone", "salary", "bonus")
+	if err != nil {
+		return err
+	}
+	if len(fields) > 0 {
+		r = nil
+	}
+	professor, err := sch.ProfessorRepo.ReadByID(ctx, id)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		return ErrProfessorNotFound
+	} else if err != nil {
+		return err
+	}
+	cfg, err := getProfessorUpdate(r, w, fields, professor)
+	if err != nil {
+		return err
+	}
+	if *cfg == *professor {
+		fmt.Fprintln(w, "Nothing to update.")
+		return nil
+	}
+	if err = SaveProfessor(ctx, sch, cfg); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "Professor updated.")
+	return nil
+}
+
+// SaveProfessor validates cfg and replaces the professor with cfg's id by cfg, unless another professor already has its name.
+func SaveProfessor(ctx context.Context, sch *ports.SchoolService, cfg *professors.Professor) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	err := sch.InTransaction(ctx, func(uow *ports.UnitOfWork) error {
+		current, err := uow.ProfessorRepo.ReadByID(ctx, cfg.ID)
+		if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+			return ErrProfessorNotFound
+		} else if err != nil {
+			return err
+		}
+		if *current == *cfg {
+			return nil
+		}
+		if cfg.Name != current.Name {
+			if _, err := uow.ProfessorRepo.ReadByName(ctx, cfg.Name); err == nil {
+				return ErrObjectAlreadyExists
+			} else if !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+				return err
+			}
+		}
+		return uow.ProfessorRepo.Update(ctx, cfg)
+	})
+	if errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		return ErrProfessorNotFound
+	}
+	return alreadyExists(err)
+}
+
+func getProfessorUpdate(r io.Reader, w io.Writer, fields map[string]string, current *professors.Professor) (*professors.Professor, error) {
+	scanner := newScanner(r)
+	professor := *current
+	text, err := answerDefault(scanner, w, fields, "name", "professor name", current.Name)
+	if err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.Name, err = parsePersonName(text); err != nil {
+		return new(professors.Professor), err
+	}
+	if text, err = answerDefault(scanner, w, fields, "age", "age", strconv.FormatUint(uint64(current.Age), 10)); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.Age, err = parseAge(text); err != nil {
+		return new(professors.Professor), err
+	}
+	if text, err = answerDefault(scanner, w, fields, "address", "address", current.Address); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.Address, err = parseAddress(text); err != nil {
+		return new(professors.Professor), err
+	}
+	if text, err = answerDefault(scanner, w, fields, "phone", "phone", strconv.FormatUint(uint64(current.Phone), 10)); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.Phone, err = parsePhone(text); err != nil {
+		return new(professors.Professor), err
+	}
+	if text, err = answerDefault(scanner, w, fields, "salary", "salary", strconv.FormatFloat(current.Salary, 'f', -1, 64)); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.Salary, err = parseSalary(text); err != nil {
+		return new(professors.Professor), err
+	}
+	if text, err = answerDefault(scanner, w, fields, "bonus", "if received bonus (y/n)", formatYesNo(current.IfReceivedBonus)); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.IfReceivedBonus, err = parseYesNo(text); err != nil {
+		return new(professors.Professor), err
+	}
+	return &professor, nil
+}
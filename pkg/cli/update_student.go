@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/xHappyface/school/api/ports"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+// UpdateStudent sets the fields of the student with id that args give as key=value, as NewStudent
+// takes them. When args give none it prompts for each field, unless r is nil.
+func UpdateStudent(ctx context.Context, r io.Reader, w io.Writer, sch *ports.SchoolService, id string, args []string) error {
+	fields, err := ParseFields(args, "name", "age", "address", "phone", "international", "probation")
+	if err != nil {
+		return err
+	}
+	if len(fields) > 0 {
+		r = nil
+	}
+	student, err := sch.StudentRepo.ReadByID(ctx, id)
+	if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+		return ErrStudentNotFound
+	} else if err != nil {
+		return err
+	}
+	cfg, err := getStudentUpdate(r, w, fields, student)
+	if err != nil {
+		return err
+	}
+	if *cfg == *student {
+		fmt.Fprintln(w, "Nothing to update.")
+		return nil
+	}
+	if err = SaveStudent(ctx, sch, cfg); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "Student updated.")
+	return nil
+}
+
+// SaveStudent validates cfg and replaces the student with cfg's id by cfg, unless another student already has its name.
+func SaveStudent(ctx context.Context, sch *ports.SchoolService, cfg *students.Student) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	err := sch.InTransaction(ctx, func(uow *ports.UnitOfWork) error {
+		current, err := uow.StudentRepo.ReadByID(ctx, cfg.ID)
+		if errors.Is(err, mysql_db.ErrZeroRowsRetrieved) {
+			return ErrStudentNotFound
+		} else if err != nil {
+			return err
+		}
+		if *current == *cfg {
+			return nil
+		}
+		if cfg.Name != current.Name {
+			if _, err := uow.StudentRepo.ReadByName(ctx, cfg.Name); err == nil {
+				return ErrObjectAlreadyExists
+			} else if !(errors.Is(err, mysql_db.ErrZeroRowsRetrieved)) {
+				return err
+			}
+		}
+		return uow.StudentRepo.Update(ctx, cfg)
+	})
+	if errors.Is(err, mysql_db.ErrZeroRowsAffected) {
+		return ErrStudentNotFound
+	}
+	return alreadyExists(err)
+}
+
+func getStudentUpdate(r io.Reader, w io.Writer, fields map[string]string, current *students.Student) (*students.Student, error) {
+	scanner := newScanner(r)
+	student := *current
+	text, err := answerDefault(scanner, w, fields, "name", "student name", current.Name)
+	if err != nil {
+		return new(students.Student), err
+	}
+	if student.Name, err = parsePersonName(text); err != nil {
+		return new(students.Student), err
+	}
+	if text, err = answerDefault(scanner, w, fields, "age", "age", strconv.FormatUint(uint64(current.Age), 10)); err != nil {
+		return new(students.Student), err
+	}
+	if student.Age, err = parseAge(text); err != nil {
+		return new(students.Student), err
+	}
+	if text, err = answerDefault(scanner, w, fields, "address", "address", current.Address); err != nil {
+		return new(students.Student), err
+	}
+	if student.Address, err = parseAddress(text); err != nil {
+		return new(students.Student), err
+	}
+	if text, err = answerDefault(scanner, w, fields, "phone", "phone", strconv.FormatUint(uint64(current.Phone), 10)); err != nil {
+		return new(students.Student), err
+	}
+	if student.Phone, err = parsePhone(text); err != nil {
+		return new(students.Student), err
+	}
+	if text, err = answerDefault(scanner, w, fields, "international", "if international (y/n)", formatYesNo(current.IfInternational)); err != nil {
+		return new(students.Student), err
+	}
+	if student.IfInternational, err = parseYesNo(text); err != nil {
+		return new(students.Student), err
+	}
+	if text, err = answerDefault(scanner, w, fields, "probation", "if on probation (y/n)", formatYesNo(current.IfOnProbation)); err != nil {
+		return new(students.Student), err
+	}
+	if student.IfOnProbation, err = parseYesNo(text); err != nil {
+		return new(students.Student), err
+	}
+	return &student, nil
+}
@@ -0,0 +1,74 @@
+package sqldb
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Placeholder styles of the parameters in a query.
+const (
+	// PLACEHOLDER_QUESTION marks each parameter '?', as mysql and sqlite expect.
+	PLACEHOLDER_QUESTION uint8 = iota
+	// PLACEHOLDER_DOLLAR numbers the parameters '$1', '$2'..., as postgres expects.
+	PLACEHOLDER_DOLLAR
+)
+
+// ListQuery assembles the where, order by and limit clauses of a keyset paginated select.
+// Conditions are written with '?' placeholders, Build renumbers them in its placeholder style.
+type ListQuery struct {
+	placeholder uint8
+	conds       []string
+	args        []any
+}
+
+func NewListQuery(placeholder uint8) *ListQuery {
+	return &ListQuery{placeholder: placeholder}
+}
+
+func (q *ListQuery) Where(cond string, args ...any) {
+	q.conds = append(q.conds, cond)
+	q.args = append(q.args, args...)
+}
+
+// NamePrefix matches names starting with prefix, escaping like wildcards with '!'.
+func (q *ListQuery) NamePrefix(prefix string) {
+	if prefix == "" {
+		return
+	}
+	escaped := strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(prefix)
+	q.Where("name like ? escape '!'", escaped+"%")
+}
+
+// After skips every row up to and including the one with the given sort value and id.
+func (q *ListQuery) After(column string, value any, id string) {
+	q.Where(fmt.Sprintf("(%s > ? or (%s = ? and id > ?))", column, column), value, value, id)
+}
+
+func (q *ListQuery) Build(selectFrom string, column string, limit uint) (string, []any) {
+	var query strings.Builder
+	query.WriteString(selectFrom)
+	if len(q.conds) > 0 {
+		query.WriteString(" where ")
+		query.WriteString(strings.Join(q.conds, " and "))
+	}
+	fmt.Fprintf(&query, " order by %s, id limit %d;", column, limit)
+	if q.placeholder == PLACEHOLDER_DOLLAR {
+		return rebind(query.String()), q.args
+	}
+	return query.String(), q.args
+}
+
+// rebind numbers each '?' placeholder of query in order.
+func rebind(query string) string {
+	var out strings.Builder
+	n := 0
+	for _, r := range query {
+		if r != '?' {
+			out.WriteRune(r)
+			continue
+		}
+		n++
+		fmt.Fprintf(&out, "$%d", n)
+	}
+	return out.String()
+}
@@ -0,0 +1,38 @@
+package sqldb
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListQuery(t *testing.T) {
+	tests := []struct {
+		placeholder uint8
+		want        string
+	}{
+		{PLACEHOLDER_QUESTION, "select id from t where name like ? escape '!' and age >= ? and (name > ? or (name = ? and id > ?)) order by name, id limit 11;"},
+		{PLACEHOLDER_DOLLAR, "select id from t where name like $1 escape '!' and age >= $2 and (name > $3 or (name = $4 and id > $5)) order by name, id limit 11;"},
+	}
+	for _, test := range tests {
+		q := NewListQuery(test.placeholder)
+		q.NamePrefix("a_b%")
+		q.Where("age >= ?", 20)
+		q.After("name", "x", "id1")
+		query, args := q.Build("select id from t", "name", 11)
+		if query != test.want {
+			t.Errorf("got %q, want %q", query, test.want)
+		}
+		if want := []any{"a!_b!%%", 20, "x", "x", "id1"}; !(reflect.DeepEqual(args, want)) {
+			t.Errorf("got args %v, want %v", args, want)
+		}
+	}
+}
+
+func TestListQueryUnfiltered(t *testing.T) {
+	q := NewListQuery(PLACEHOLDER_DOLLAR)
+	q.NamePrefix("")
+	query, args := q.Build("select id from t", "name", 5)
+	if want := "select id from t order by name, id limit 5;"; query != want || len(args) != 0 {
+		t.Errorf("got %q %v, want %q", query, args, want)
+	}
+}
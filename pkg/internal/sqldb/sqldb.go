@@ -0,0 +1,73 @@
+// Package sqldb holds what the database/sql backends share: running statements
+// on the connection pool or a transaction begun from it.
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+var (
+	ErrNoTransaction   = errors.New("no transaction in progress")
+	ErrTransactionOpen = errors.New("transaction already in progress")
+)
+
+// Querier is what the repositories run statements on: the connection pool, or a transaction.
+type Querier interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// Conn is a connection pool, or a transaction begun from one.
+type Conn struct {
+	pool *sql.DB
+	tx   *sql.Tx
+}
+
+func NewConn(pool *sql.DB) *Conn {
+	return &Conn{pool: pool}
+}
+
+// Querier returns the transaction of conn, or its pool outside of one.
+func (conn *Conn) Querier() Querier {
+	if conn.tx != nil {
+		return conn.tx
+	}
+	return conn.pool
+}
+
+// Begin starts a transaction and returns a Conn bound to it.
+func (conn *Conn) Begin(ctx context.Context) (*Conn, error) {
+	if conn.tx != nil {
+		return new(Conn), ErrTransactionOpen
+	}
+	tx, err := conn.pool.BeginTx(ctx, nil)
+	if err != nil {
+		return new(Conn), err
+	}
+	return &Conn{pool: conn.pool, tx: tx}, nil
+}
+
+func (conn *Conn) Commit() error {
+	if conn.tx == nil {
+		return ErrNoTransaction
+	}
+	return conn.tx.Commit()
+}
+
+func (conn *Conn) Rollback() error {
+	if conn.tx == nil {
+		return ErrNoTransaction
+	}
+	return conn.tx.Rollback()
+}
+
+// Close closes the connection pool, a Conn bound to a transaction leaves that to the Conn it began from.
+func (conn *Conn) Close() error {
+	if conn.tx != nil {
+		return nil
+	}
+	return conn.pool.Close()
+}
@@ -0,0 +1,87 @@
+package memory_db
+
+import (
+	"context"
+	"github.com/xHappyface/school/api/assignments"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+type MemoryAssignmentRepository struct {
+	db     *School
+	logger *logger.SchoolLogger
+}
+
+func NewMemoryAssignmentRepository(db *School, l *logger.SchoolLogger) *MemoryAssignmentRepository {
+	return &MemoryAssignmentRepository{
+		db:     db,
+		logger: l,
+	}
+}
+
+func (repo *MemoryAssignmentRepository) Assign(ctx context.Context, cfg *assignments.Assignment) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	_, okProfessor := repo.db.professors[cfg.ProfessorID]
+	_, okCourse := repo.db.courses[cfg.CourseID]
+	if !(okProfessor && okCourse) {
+		return ErrMissingReference
+	}
+	assigned, ok := repo.db.assignments[cfg.ProfessorID]
+	if !ok {
+		assigned = make(map[string]assignments.Assignment)
+		repo.db.assignments[cfg.ProfessorID] = assigned
+	}
+	if _, ok = assigned[cfg.CourseID]; ok {
+		return ErrDuplicateKey
+	}
+	assigned[cfg.CourseID] = *cfg
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor assigned")
+	return nil
+}
+
+func (repo *MemoryAssignmentRepository) Unassign(ctx context.Context, professorID string, courseID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	if _, ok := repo.db.assignments[professorID][courseID]; !ok {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	delete(repo.db.assignments[professorID], courseID)
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor unassigned")
+	return nil
+}
+
+func (repo *MemoryAssignmentRepository) ListByProfessor(ctx context.Context, professorID string) ([]*assignments.Assignment, error) {
+	if err := ctx.Err(); err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	repo.db.mu.RLock()
+	defer repo.db.mu.RUnlock()
+	list := []*assignments.Assignment{}
+	for _, assignment := range repo.db.assignments[professorID] {
+		assignment := assignment
+		list = append(list, &assignment)
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "assignments retrieved")
+	return list, nil
+}
+
+func (repo *MemoryAssignmentRepository) ListByCourse(ctx context.Context, courseID string) ([]*assignments.Assignment, error) {
+	if err := ctx.Err(); err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	repo.db.mu.RLock()
+	defer repo.db.mu.RUnlock()
+	list := []*assignments.Assignment{}
+	for _, assigned := range repo.db.assignments {
+		if assignment, ok := assigned[courseID]; ok {
+			list = append(list, &assignment)
+		}
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "assignments retrieved")
+	return list, nil
+}
@@ -0,0 +1,136 @@
+package memory_db
+
+import (
+	"context"
+	"strings"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+type MemoryCourseRepository struct {
+	db     *School
+	logger *logger.SchoolLogger
+}
+
+func NewMemoryCourseRepository(db *School, l *logger.SchoolLogger) *MemoryCourseRepository {
+	return &MemoryCourseRepository{
+		db:     db,
+		logger: l,
+	}
+}
+
+func (repo *MemoryCourseRepository) Create(ctx context.Context, cfg *courses.Course) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	if _, ok := repo.db.courses[cfg.ID]; ok {
+		return ErrDuplicateKey
+	}
+	repo.db.courses[cfg.ID] = *cfg
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "course created")
+	return nil
+}
+
+func (repo *MemoryCourseRepository) ReadByID(ctx context.Context, id string) (*courses.Course, error) {
+	if err := ctx.Err(); err != nil {
+		return new(courses.Course), err
+	}
+	repo.db.mu.RLock()
+	defer repo.db.mu.RUnlock()
+	course, ok := repo.db.courses[id]
+	if !ok {
+		return new(courses.Course), mysql_db.ErrZeroRowsRetrieved
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "course retrieved")
+	return &course, nil
+}
+
+func (repo *MemoryCourseRepository) ReadByName(ctx context.Context, name string) (*courses.Course, error) {
+	if err := ctx.Err(); err != nil {
+		return new(courses.Course), err
+	}
+	repo.db.mu.RLock()
+	defer repo.db.mu.RUnlock()
+	for _, course := range repo.db.courses {
+		if course.Name == name {
+			repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "course retrieved")
+			return &course, nil
+		}
+	}
+	return new(courses.Course), mysql_db.ErrZeroRowsRetrieved
+}
+
+// List returns one page of the courses matching filter and the cursor of the next page, empty on the last page.
+func (repo *MemoryCourseRepository) List(ctx context.Context, filter *courses.Filter, pg *pagination.Page) ([]*courses.Course, string, error) {
+	if err := ctx.Err(); err != nil {
+		return []*courses.Course{}, "", err
+	}
+	sortKey, err := pg.SortKey(pagination.SORT_NAME)
+	if err != nil {
+		return []*courses.Course{}, "", err
+	}
+	after, err := pg.After()
+	if err != nil {
+		return []*courses.Course{}, "", err
+	}
+	repo.db.mu.RLock()
+	items := make([]item[*courses.Course], 0, len(repo.db.courses))
+	for _, course := range repo.db.courses {
+		course := course
+		if !(strings.HasPrefix(course.Name, filter.NamePrefix)) {
+			continue
+		}
+		items = append(items, item[*courses.Course]{key: course.Name, id: course.ID, value: &course})
+	}
+	repo.db.mu.RUnlock()
+	list, next, err := page(items, sortKey, after, pg.Size(), func(course *courses.Course) *pagination.Cursor {
+		return course.Cursor(sortKey)
+	})
+	if err != nil {
+		return []*courses.Course{}, "", err
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "courses retrieved")
+	return list, next, nil
+}
+
+func (repo *MemoryCourseRepository) Update(ctx context.Context, cfg *courses.Course) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	if _, ok := repo.db.courses[cfg.ID]; !ok {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.db.courses[cfg.ID] = *cfg
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "course updated")
+	return nil
+}
+
+func (repo *MemoryCourseRepository) DeleteByID(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	if _, ok := repo.db.courses[id]; !ok {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	delete(repo.db.courses, id)
+	for _, enrolled := range repo.db.enrollments {
+		delete(enrolled, id)
+	}
+	for _, assigned := range repo.db.assignments {
+		delete(assigned, id)
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "course deleted")
+	return nil
+}
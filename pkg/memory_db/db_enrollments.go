@@ -0,0 +1,94 @@
+package memory_db
+
+import (
+	"context"
+	"sort"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/enrollments"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+type MemoryEnrollmentRepository struct {
+	db     *School
+	logger *logger.SchoolLogger
+}
+
+func NewMemoryEnrollmentRepository(db *School, l *logger.SchoolLogger) *MemoryEnrollmentRepository {
+	return &MemoryEnrollmentRepository{
+		db:     db,
+		logger: l,
+	}
+}
+
+func (repo *MemoryEnrollmentRepository) Enroll(ctx context.Context, cfg *enrollments.Enrollment) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	_, okStudent := repo.db.students[cfg.StudentID]
+	_, okCourse := repo.db.courses[cfg.CourseID]
+	if !(okStudent && okCourse) {
+		return ErrMissingReference
+	}
+	enrolled, ok := repo.db.enrollments[cfg.StudentID]
+	if !ok {
+		enrolled = make(map[string]enrollments.Enrollment)
+		repo.db.enrollments[cfg.StudentID] = enrolled
+	}
+	if _, ok = enrolled[cfg.CourseID]; ok {
+		return ErrDuplicateKey
+	}
+	enrolled[cfg.CourseID] = *cfg
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student enrolled")
+	return nil
+}
+
+func (repo *MemoryEnrollmentRepository) Drop(ctx context.Context, studentID string, courseID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	if _, ok := repo.db.enrollments[studentID][courseID]; !ok {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	delete(repo.db.enrollments[studentID], courseID)
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student dropped")
+	return nil
+}
+
+func (repo *MemoryEnrollmentRepository) ListCoursesByStudent(ctx context.Context, studentID string) ([]*courses.Course, error) {
+	if err := ctx.Err(); err != nil {
+		return []*courses.Course{}, err
+	}
+	repo.db.mu.RLock()
+	defer repo.db.mu.RUnlock()
+	list := []*courses.Course{}
+	for courseID := range repo.db.enrollments[studentID] {
+		course := repo.db.courses[courseID]
+		list = append(list, &course)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "courses retrieved")
+	return list, nil
+}
+
+func (repo *MemoryEnrollmentRepository) ListStudentsByCourse(ctx context.Context, courseID string) ([]*students.Student, error) {
+	if err := ctx.Err(); err != nil {
+		return []*students.Student{}, err
+	}
+	repo.db.mu.RLock()
+	defer repo.db.mu.RUnlock()
+	list := []*students.Student{}
+	for studentID, enrolled := range repo.db.enrollments {
+		if _, ok := enrolled[courseID]; ok {
+			student := repo.db.students[studentID]
+			list = append(list, &student)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "students retrieved")
+	return list, nil
+}
@@ -0,0 +1,143 @@
+package memory_db
+
+import (
+	"context"
+	"strings"
+
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+type MemoryProfessorRepository struct {
+	db     *School
+	logger *logger.SchoolLogger
+}
+
+func NewMemoryProfessorRepository(db *School, l *logger.SchoolLogger) *MemoryProfessorRepository {
+	return &MemoryProfessorRepository{
+		db:     db,
+		logger: l,
+	}
+}
+
+func (repo *MemoryProfessorRepository) Create(ctx context.Context, cfg *professors.Professor) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	if _, ok := repo.db.professors[cfg.ID]; ok {
+		return ErrDuplicateKey
+	}
+	repo.db.professors[cfg.ID] = *cfg
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor created")
+	return nil
+}
+
+func (repo *MemoryProfessorRepository) ReadByID(ctx context.Context, id string) (*professors.Professor, error) {
+	if err := ctx.Err(); err != nil {
+		return new(professors.Professor), err
+	}
+	repo.db.mu.RLock()
+	defer repo.db.mu.RUnlock()
+	professor, ok := repo.db.professors[id]
+	if !ok {
+		return new(professors.Professor), mysql_db.ErrZeroRowsRetrieved
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor retrieved")
+	return &professor, nil
+}
+
+func (repo *MemoryProfessorRepository) ReadByName(ctx context.Context, name string) (*professors.Professor, error) {
+	if err := ctx.Err(); err != nil {
+		return new(professors.Professor), err
+	}
+	repo.db.mu.RLock()
+	defer repo.db.mu.RUnlock()
+	for _, professor := range repo.db.professors {
+		if professor.Name == name {
+			repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor retrieved")
+			return &professor, nil
+		}
+	}
+	return new(professors.Professor), mysql_db.ErrZeroRowsRetrieved
+}
+
+// List returns one page of the professors matching filter and the cursor of the next page, empty on the last page.
+func (repo *MemoryProfessorRepository) List(ctx context.Context, filter *professors.Filter, pg *pagination.Page) ([]*professors.Professor, string, error) {
+	if err := ctx.Err(); err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	sortKey, err := pg.SortKey(pagination.SORT_NAME, pagination.SORT_AGE, pagination.SORT_SALARY)
+	if err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	after, err := pg.After()
+	if err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	repo.db.mu.RLock()
+	items := make([]item[*professors.Professor], 0, len(repo.db.professors))
+	for _, professor := range repo.db.professors {
+		professor := professor
+		if !(strings.HasPrefix(professor.Name, filter.NamePrefix)) ||
+			(filter.MinAge > 0 && professor.Age < filter.MinAge) ||
+			(filter.MaxAge > 0 && professor.Age > filter.MaxAge) ||
+			(filter.MinSalary != nil && professor.Salary < *filter.MinSalary) ||
+			(filter.MaxSalary != nil && professor.Salary > *filter.MaxSalary) ||
+			(filter.IfReceivedBonus != nil && professor.IfReceivedBonus != *filter.IfReceivedBonus) {
+			continue
+		}
+		var key any = professor.Name
+		switch sortKey {
+		case pagination.SORT_AGE:
+			key = uint64(professor.Age)
+		case pagination.SORT_SALARY:
+			key = professor.Salary
+		}
+		items = append(items, item[*professors.Professor]{key: key, id: professor.ID, value: &professor})
+	}
+	repo.db.mu.RUnlock()
+	list, next, err := page(items, sortKey, after, pg.Size(), func(professor *professors.Professor) *pagination.Cursor {
+		return professor.Cursor(sortKey)
+	})
+	if err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professors retrieved")
+	return list, next, nil
+}
+
+func (repo *MemoryProfessorRepository) Update(ctx context.Context, cfg *professors.Professor) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	if _, ok := repo.db.professors[cfg.ID]; !ok {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.db.professors[cfg.ID] = *cfg
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor updated")
+	return nil
+}
+
+func (repo *MemoryProfessorRepository) DeleteByID(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	if _, ok := repo.db.professors[id]; !ok {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	delete(repo.db.professors, id)
+	delete(repo.db.assignments, id)
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor deleted")
+	return nil
+}
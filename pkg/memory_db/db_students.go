@@ -0,0 +1,139 @@
+package memory_db
+
+import (
+	"context"
+	"strings"
+
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+type MemoryStudentRepository struct {
+	db     *School
+	logger *logger.SchoolLogger
+}
+
+func NewMemoryStudentRepository(db *School, l *logger.SchoolLogger) *MemoryStudentRepository {
+	return &MemoryStudentRepository{
+		db:     db,
+		logger: l,
+	}
+}
+
+func (repo *MemoryStudentRepository) Create(ctx context.Context, cfg *students.Student) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	if _, ok := repo.db.students[cfg.ID]; ok {
+		return ErrDuplicateKey
+	}
+	repo.db.students[cfg.ID] = *cfg
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student created")
+	return nil
+}
+
+func (repo *MemoryStudentRepository) ReadByID(ctx context.Context, id string) (*students.Student, error) {
+	if err := ctx.Err(); err != nil {
+		return new(students.Student), err
+	}
+	repo.db.mu.RLock()
+	defer repo.db.mu.RUnlock()
+	student, ok := repo.db.students[id]
+	if !ok {
+		return new(students.Student), mysql_db.ErrZeroRowsRetrieved
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student retrieved")
+	return &student, nil
+}
+
+func (repo *MemoryStudentRepository) ReadByName(ctx context.Context, name string) (*students.Student, error) {
+	if err := ctx.Err(); err != nil {
+		return new(students.Student), err
+	}
+	repo.db.mu.RLock()
+	defer repo.db.mu.RUnlock()
+	for _, student := range repo.db.students {
+		if student.Name == name {
+			repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student retrieved")
+			return &student, nil
+		}
+	}
+	return new(students.Student), mysql_db.ErrZeroRowsRetrieved
+}
+
+// List returns one page of the students matching filter and the cursor of the next page, empty on the last page.
+func (repo *MemoryStudentRepository) List(ctx context.Context, filter *students.Filter, pg *pagination.Page) ([]*students.Student, string, error) {
+	if err := ctx.Err(); err != nil {
+		return []*students.Student{}, "", err
+	}
+	sortKey, err := pg.SortKey(pagination.SORT_NAME, pagination.SORT_AGE)
+	if err != nil {
+		return []*students.Student{}, "", err
+	}
+	after, err := pg.After()
+	if err != nil {
+		return []*students.Student{}, "", err
+	}
+	repo.db.mu.RLock()
+	items := make([]item[*students.Student], 0, len(repo.db.students))
+	for _, student := range repo.db.students {
+		student := student
+		if !(strings.HasPrefix(student.Name, filter.NamePrefix)) ||
+			(filter.MinAge > 0 && student.Age < filter.MinAge) ||
+			(filter.MaxAge > 0 && student.Age > filter.MaxAge) ||
+			(filter.IfInternational != nil && student.IfInternational != *filter.IfInternational) ||
+			(filter.IfOnProbation != nil && student.IfOnProbation != *filter.IfOnProbation) {
+			continue
+		}
+		var key any = student.Name
+		if sortKey == pagination.SORT_AGE {
+			key = uint64(student.Age)
+		}
+		items = append(items, item[*students.Student]{key: key, id: student.ID, value: &student})
+	}
+	repo.db.mu.RUnlock()
+	list, next, err := page(items, sortKey, after, pg.Size(), func(student *students.Student) *pagination.Cursor {
+		return student.Cursor(sortKey)
+	})
+	if err != nil {
+		return []*students.Student{}, "", err
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "students retrieved")
+	return list, next, nil
+}
+
+func (repo *MemoryStudentRepository) Update(ctx context.Context, cfg *students.Student) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	if _, ok := repo.db.students[cfg.ID]; !ok {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.db.students[cfg.ID] = *cfg
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student updated")
+	return nil
+}
+
+func (repo *MemoryStudentRepository) DeleteByID(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer repo.db.lock()()
+	if _, ok := repo.db.students[id]; !ok {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	delete(repo.db.students, id)
+	delete(repo.db.enrollments, id)
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student deleted")
+	return nil
+}
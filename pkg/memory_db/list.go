@@ -0,0 +1,74 @@
+package memory_db
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/xHappyface/school/api/pagination"
+)
+
+// item is one candidate row of a list, keyed by its sort value.
+type item[T any] struct {
+	key   any
+	id    string
+	value T
+}
+
+// page sorts items by key then id, skips those up to after and returns at most size of them
+// with the cursor of the next page.
+func page[T any](items []item[T], sortKey string, after *pagination.Cursor, size uint, cursor func(T) *pagination.Cursor) ([]T, string, error) {
+	sort.Slice(items, func(i, j int) bool {
+		return less(items[i].key, items[i].id, items[j].key, items[j].id)
+	})
+	start := 0
+	if after != nil {
+		key, err := after.Value(sortKey)
+		if err != nil {
+			return []T{}, "", err
+		}
+		start = sort.Search(len(items), func(i int) bool {
+			return less(key, after.ID, items[i].key, items[i].id)
+		})
+	}
+	list := []T{}
+	for _, it := range items[start:] {
+		list = append(list, it.value)
+	}
+	var next string
+	if uint(len(list)) > size {
+		list = list[:size]
+		next = cursor(list[len(list)-1]).String()
+	}
+	return list, next, nil
+}
+
+func less(a any, aID string, b any, bID string) bool {
+	c := compare(a, b)
+	if c == 0 {
+		return aID < bID
+	}
+	return c < 0
+}
+
+// compare orders two sort values of the same kind: string, uint64 or float64.
+func compare(a any, b any) int {
+	switch a := a.(type) {
+	case string:
+		return strings.Compare(a, b.(string))
+	case uint64:
+		b := b.(uint64)
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+	case float64:
+		b := b.(float64)
+		if a < b {
+			return -1
+		} else if a > b {
+			return 1
+		}
+	}
+	return 0
+}
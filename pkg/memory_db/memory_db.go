@@ -0,0 +1,142 @@
+package memory_db
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/xHappyface/school/api/assignments"
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/enrollments"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+var (
+	ErrDuplicateKey     = mysql_db.ErrDuplicateKey
+	ErrMissingReference = errors.New("referenced row does not exist")
+)
+
+type School struct {
+	// txMu is held by writes and for the whole life of a transaction, serializing them.
+	txMu       sync.Mutex
+	mu         sync.RWMutex
+	courses    map[string]courses.Course
+	professors map[string]professors.Professor
+	students   map[string]students.Student
+	// enrollments is keyed by student id, then course id.
+	enrollments map[string]map[string]enrollments.Enrollment
+	// assignments is keyed by professor id, then course id.
+	assignments map[string]map[string]assignments.Assignment
+
+	// parent is the School a transaction began from, nil outside of transactions.
+	parent *School
+	done   bool
+}
+
+// NewSchoolDB returns the address of an empty in-memory school database.
+// Its contents only live as long as the process does.
+func NewSchoolDB(l *logger.SchoolLogger) *School {
+	l.Log(logger.LOG_LEVEL_INFO, "using in-memory database")
+	return &School{
+		courses:     make(map[string]courses.Course),
+		professors:  make(map[string]professors.Professor),
+		students:    make(map[string]students.Student),
+		enrollments: make(map[string]map[string]enrollments.Enrollment),
+		assignments: make(map[string]map[string]assignments.Assignment),
+	}
+}
+
+func (schoolDB *School) Close() error {
+	return nil
+}
+
+// lock acquires the database for a write and returns the function releasing it.
+func (schoolDB *School) lock() func() {
+	schoolDB.txMu.Lock()
+	schoolDB.mu.Lock()
+	return func() {
+		schoolDB.mu.Unlock()
+		schoolDB.txMu.Unlock()
+	}
+}
+
+// Begin snapshots the database into a School that Commit writes back.
+// Until then every write outside the transaction waits, so none is lost on commit.
+func (schoolDB *School) Begin(ctx context.Context) (*School, error) {
+	if schoolDB.parent != nil {
+		return new(School), mysql_db.ErrTransactionOpen
+	}
+	if err := ctx.Err(); err != nil {
+		return new(School), err
+	}
+	schoolDB.txMu.Lock()
+	schoolDB.mu.RLock()
+	tx := schoolDB.clone()
+	schoolDB.mu.RUnlock()
+	tx.parent = schoolDB
+	return tx, nil
+}
+
+func (schoolDB *School) Commit() error {
+	if schoolDB.parent == nil || schoolDB.done {
+		return mysql_db.ErrNoTransaction
+	}
+	schoolDB.done = true
+	schoolDB.mu.RLock()
+	committed := schoolDB.clone()
+	schoolDB.mu.RUnlock()
+	parent := schoolDB.parent
+	parent.mu.Lock()
+	parent.courses = committed.courses
+	parent.professors = committed.professors
+	parent.students = committed.students
+	parent.enrollments = committed.enrollments
+	parent.assignments = committed.assignments
+	parent.mu.Unlock()
+	parent.txMu.Unlock()
+	return nil
+}
+
+func (schoolDB *School) Rollback() error {
+	if schoolDB.parent == nil || schoolDB.done {
+		return mysql_db.ErrNoTransaction
+	}
+	schoolDB.done = true
+	schoolDB.parent.txMu.Unlock()
+	return nil
+}
+
+func (schoolDB *School) clone() *School {
+	c := &School{
+		courses:     make(map[string]courses.Course, len(schoolDB.courses)),
+		professors:  make(map[string]professors.Professor, len(schoolDB.professors)),
+		students:    make(map[string]students.Student, len(schoolDB.students)),
+		enrollments: make(map[string]map[string]enrollments.Enrollment, len(schoolDB.enrollments)),
+		assignments: make(map[string]map[string]assignments.Assignment, len(schoolDB.assignments)),
+	}
+	for id, course := range schoolDB.courses {
+		c.courses[id] = course
+	}
+	for id, professor := range schoolDB.professors {
+		c.professors[id] = professor
+	}
+	for id, student := range schoolDB.students {
+		c.students[id] = student
+	}
+	for studentID, enrolled := range schoolDB.enrollments {
+		c.enrollments[studentID] = make(map[string]enrollments.Enrollment, len(enrolled))
+		for courseID, enrollment := range enrolled {
+			c.enrollments[studentID][courseID] = enrollment
+		}
+	}
+	for professorID, assigned := range schoolDB.assignments {
+		c.assignments[professorID] = make(map[string]assignments.Assignment, len(assigned))
+		for courseID, assignment := range assigned {
+			c.assignments[professorID][courseID] = assignment
+		}
+	}
+	return c
+}
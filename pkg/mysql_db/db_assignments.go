@@ -0,0 +1,136 @@
+package mysql_db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/xHappyface/school/api/assignments"
+	"github.com/xHappyface/school/logger"
+)
+
+type SQLAssignmentRepository struct {
+	db                  *School
+	ctxTimeMilliseconds uint
+	logger              *logger.SchoolLogger
+}
+
+func NewSQLAssignmentRepository(db *School, milliseconds uint, l *logger.SchoolLogger) *SQLAssignmentRepository {
+	return &SQLAssignmentRepository{
+		db:                  db,
+		ctxTimeMilliseconds: milliseconds,
+		logger:              l,
+	}
+}
+
+func (repo *SQLAssignmentRepository) Assign(ctx context.Context, cfg *assignments.Assignment) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	stmt, err := repo.db.school.PrepareContext(ctx, "insert into assignments(professor_id, course_id, role) values (?, ?, ?);")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, cfg.ProfessorID, cfg.CourseID, cfg.Role)
+	if err != nil {
+		return duplicateKey(err)
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor assigned")
+	return nil
+}
+
+func (repo *SQLAssignmentRepository) Unassign(ctx context.Context, professorID string, courseID string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	stmt, err := repo.db.school.PrepareContext(ctx, "delete from assignments where professor_id=? and course_id=?;")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, professorID, courseID)
+	if err != nil {
+		return err
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor unassigned")
+	return nil
+}
+
+func (repo *SQLAssignmentRepository) ListByProfessor(ctx context.Context, professorID string) ([]*assignments.Assignment, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	stmt, err := repo.db.school.PrepareContext(ctx, "select professor_id, course_id, role from assignments where professor_id=?;")
+	if err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	defer stmt.Close()
+	var rows *Rows
+	rows, err = stmt.QueryContext(ctx, professorID)
+	if err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*assignments.Assignment{}
+	for rows.Next() {
+		assignment := new(assignments.Assignment)
+		err = rows.Scan(&assignment.ProfessorID, &assignment.CourseID, &assignment.Role)
+		if err != nil {
+			return []*assignments.Assignment{}, err
+		}
+		list = append(list, assignment)
+	}
+	if err = rows.Err(); err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "assignments retrieved")
+	return list, nil
+}
+
+func (repo *SQLAssignmentRepository) ListByCourse(ctx context.Context, courseID string) ([]*assignments.Assignment, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	stmt, err := repo.db.school.PrepareContext(ctx, "select professor_id, course_id, role from assignments where course_id=?;")
+	if err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	defer stmt.Close()
+	var rows *Rows
+	rows, err = stmt.QueryContext(ctx, courseID)
+	if err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*assignments.Assignment{}
+	for rows.Next() {
+		assignment := new(assignments.Assignment)
+		err = rows.Scan(&assignment.ProfessorID, &assignment.CourseID, &assignment.Role)
+		if err != nil {
+			return []*assignments.Assignment{}, err
+		}
+		list = append(list, assignment)
+	}
+	if err = rows.Err(); err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "assignments retrieved")
+	return list, nil
+}
@@ -0,0 +1,142 @@
+package mysql_db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/enrollments"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/logger"
+)
+
+type SQLEnrollmentRepository struct {
+	db                  *School
+	ctxTimeMilliseconds uint
+	logger              *logger.SchoolLogger
+}
+
+func NewSQLEnrollmentRepository(db *School, milliseconds uint, l *logger.SchoolLogger) *SQLEnrollmentRepository {
+	return &SQLEnrollmentRepository{
+		db:                  db,
+		ctxTimeMilliseconds: milliseconds,
+		logger:              l,
+	}
+}
+
+func (repo *SQLEnrollmentRepository) Enroll(ctx context.Context, cfg *enrollments.Enrollment) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	stmt, err := repo.db.school.PrepareContext(ctx, "insert into enrollments(student_id, course_id) values (?, ?);")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, cfg.StudentID, cfg.CourseID)
+	if err != nil {
+		return duplicateKey(err)
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student enrolled")
+	return nil
+}
+
+func (repo *SQLEnrollmentRepository) Drop(ctx context.Context, studentID string, courseID string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	stmt, err := repo.db.school.PrepareContext(ctx, "delete from enrollments where student_id=? and course_id=?;")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, studentID, courseID)
+	if err != nil {
+		return err
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student dropped")
+	return nil
+}
+
+func (repo *SQLEnrollmentRepository) ListCoursesByStudent(ctx context.Context, studentID string) ([]*courses.Course, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	stmt, err := repo.db.school.PrepareContext(ctx, `select c.id, c.name from courses c
+										join enrollments e on e.course_id=c.id
+										where e.student_id=? order by c.name;`)
+	if err != nil {
+		return []*courses.Course{}, err
+	}
+	defer stmt.Close()
+	var rows *Rows
+	rows, err = stmt.QueryContext(ctx, studentID)
+	if err != nil {
+		return []*courses.Course{}, err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*courses.Course{}
+	for rows.Next() {
+		course := new(courses.Course)
+		err = rows.Scan(&course.ID, &course.Name)
+		if err != nil {
+			return []*courses.Course{}, err
+		}
+		list = append(list, course)
+	}
+	if err = rows.Err(); err != nil {
+		return []*courses.Course{}, err
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "courses retrieved")
+	return list, nil
+}
+
+func (repo *SQLEnrollmentRepository) ListStudentsByCourse(ctx context.Context, courseID string) ([]*students.Student, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	stmt, err := repo.db.school.PrepareContext(ctx, `select s.id, s.name, s.age, s.address, s.phone, s.if_international, s.if_on_probation from students s
+										join enrollments e on e.student_id=s.id
+										where e.course_id=? order by s.name;`)
+	if err != nil {
+		return []*students.Student{}, err
+	}
+	defer stmt.Close()
+	var rows *Rows
+	rows, err = stmt.QueryContext(ctx, courseID)
+	if err != nil {
+		return []*students.Student{}, err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*students.Student{}
+	for rows.Next() {
+		student := new(students.Student)
+		err = rows.Scan(&student.ID, &student.Name, &student.Age, &student.Address, &student.Phone, &student.IfInternational, &student.IfOnProbation)
+		if err != nil {
+			return []*students.Student{}, err
+		}
+		list = append(list, student)
+	}
+	if err = rows.Err(); err != nil {
+		return []*students.Student{}, err
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "students retrieved")
+	return list, nil
+}
@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/xHappyface/school/api/pagination"
 	"github.com/xHappyface/school/api/professors"
 	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/internal/sqldb"
 )
 
 type SQLProfessorRepository struct {
@@ -23,21 +25,22 @@ func NewSQLProfessorRepository(db *School, milliseconds uint, l *logger.SchoolLo
 	}
 }
 
-func (repo *SQLProfessorRepository) Create(cfg *professors.Professor) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+func (repo *SQLProfessorRepository) Create(ctx context.Context, cfg *professors.Professor) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
 	defer cancel()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
 	stmt, err := repo.db.school.PrepareContext(ctx, `insert into professors(id, name, age, address, phone, salary, if_received_bonus)
 										values (?, ?, ?, ?, ?, ?, ?);`)
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
 	var result sql.Result
 	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name, cfg.Age, cfg.Address, cfg.Phone, cfg.Salary, cfg.IfReceivedBonus)
 	if err != nil {
-		return err
+		return duplicateKey(err)
 	}
 	var affected int64
 	affected, err = result.RowsAffected()
@@ -47,27 +50,25 @@ func (repo *SQLProfessorRepository) Create(cfg *professors.Professor) error {
 	if !(affected > 0) {
 		return ErrZeroRowsAffected
 	}
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "professor created")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor created")
 	return nil
 }
 
-func (repo *SQLProfessorRepository) ReadByID(id string) (*professors.Professor, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+func (repo *SQLProfessorRepository) ReadByID(ctx context.Context, id string) (*professors.Professor, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
 	defer cancel()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
-	stmt, err := repo.db.school.PrepareContext(ctx, "select * from professors where id=?;")
+	stmt, err := repo.db.school.PrepareContext(ctx, "select id, name, age, address, phone, salary, if_received_bonus from professors where id=?;")
 	if err != nil {
 		return new(professors.Professor), err
 	}
 	defer stmt.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
-	var rows *sql.Rows
+	var rows *Rows
 	rows, err = stmt.QueryContext(ctx, id)
 	if err != nil {
 		return new(professors.Professor), err
 	}
 	defer rows.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "scanning rows...")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
 	professor := new(professors.Professor)
 	for rows.Next() {
 		err = rows.Scan(&professor.ID, &professor.Name, &professor.Age, &professor.Address, &professor.Phone, &professor.Salary, &professor.IfReceivedBonus)
@@ -81,27 +82,25 @@ func (repo *SQLProfessorRepository) ReadByID(id string) (*professors.Professor,
 	if professor.ID == "" {
 		return new(professors.Professor), ErrZeroRowsRetrieved
 	}
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "professor retrieved")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor retrieved")
 	return professor, nil
 }
 
-func (repo *SQLProfessorRepository) ReadByName(name string) (*professors.Professor, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+func (repo *SQLProfessorRepository) ReadByName(ctx context.Context, name string) (*professors.Professor, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
 	defer cancel()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
-	stmt, err := repo.db.school.PrepareContext(ctx, "select * from professors where name=?;")
+	stmt, err := repo.db.school.PrepareContext(ctx, "select id, name, age, address, phone, salary, if_received_bonus from professors where name=?;")
 	if err != nil {
 		return new(professors.Professor), err
 	}
 	defer stmt.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
-	var rows *sql.Rows
+	var rows *Rows
 	rows, err = stmt.QueryContext(ctx, name)
 	if err != nil {
 		return new(professors.Professor), err
 	}
 	defer rows.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "scanning rows...")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
 	professor := new(professors.Professor)
 	for rows.Next() {
 		err = rows.Scan(&professor.ID, &professor.Name, &professor.Age, &professor.Address, &professor.Phone, &professor.Salary, &professor.IfReceivedBonus)
@@ -115,24 +114,95 @@ func (repo *SQLProfessorRepository) ReadByName(name string) (*professors.Profess
 	if professor.ID == "" {
 		return new(professors.Professor), ErrZeroRowsRetrieved
 	}
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "professor retrieved")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor retrieved")
 	return professor, nil
 }
 
-func (repo *SQLProfessorRepository) Update(cfg *professors.Professor) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+// List returns one page of the professors matching filter and the cursor of the next page, empty on the last page.
+func (repo *SQLProfessorRepository) List(ctx context.Context, filter *professors.Filter, page *pagination.Page) ([]*professors.Professor, string, error) {
+	sort, err := page.SortKey(pagination.SORT_NAME, pagination.SORT_AGE, pagination.SORT_SALARY)
+	if err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	after, err := page.After()
+	if err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	q := sqldb.NewListQuery(sqldb.PLACEHOLDER_QUESTION)
+	q.NamePrefix(filter.NamePrefix)
+	if filter.MinAge > 0 {
+		q.Where("age >= ?", filter.MinAge)
+	}
+	if filter.MaxAge > 0 {
+		q.Where("age <= ?", filter.MaxAge)
+	}
+	if filter.MinSalary != nil {
+		q.Where("salary >= ?", *filter.MinSalary)
+	}
+	if filter.MaxSalary != nil {
+		q.Where("salary <= ?", *filter.MaxSalary)
+	}
+	if filter.IfReceivedBonus != nil {
+		q.Where("if_received_bonus = ?", *filter.IfReceivedBonus)
+	}
+	if after != nil {
+		var value any
+		if value, err = after.Value(sort); err != nil {
+			return []*professors.Professor{}, "", err
+		}
+		q.After(sort, value, after.ID)
+	}
+	query, args := q.Build("select id, name, age, address, phone, salary, if_received_bonus from professors", sort, page.Size()+1)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	stmt, err := repo.db.school.PrepareContext(ctx, query)
+	if err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	defer stmt.Close()
+	var rows *Rows
+	rows, err = stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*professors.Professor{}
+	for rows.Next() {
+		professor := new(professors.Professor)
+		err = rows.Scan(&professor.ID, &professor.Name, &professor.Age, &professor.Address, &professor.Phone, &professor.Salary, &professor.IfReceivedBonus)
+		if err != nil {
+			return []*professors.Professor{}, "", err
+		}
+		list = append(list, professor)
+	}
+	if err = rows.Err(); err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	var next string
+	if uint(len(list)) > page.Size() {
+		list = list[:page.Size()]
+		next = list[len(list)-1].Cursor(sort).String()
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professors retrieved")
+	return list, next, nil
+}
+
+func (repo *SQLProfessorRepository) Update(ctx context.Context, cfg *professors.Professor) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
 	defer cancel()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
-	stmt, err := repo.db.school.PrepareContext(ctx, "update professors set id=?, name=?, age=?, address=?, phone=?, salary=? where id=?;")
+	stmt, err := repo.db.school.PrepareContext(ctx, "update professors set id=?, name=?, age=?, address=?, phone=?, salary=?, if_received_bonus=? where id=?;")
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
 	var result sql.Result
-	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name, cfg.Age, cfg.Address, cfg.Phone, cfg.Salary, cfg.ID)
+	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name, cfg.Age, cfg.Address, cfg.Phone, cfg.Salary, cfg.IfReceivedBonus, cfg.ID)
 	if err != nil {
-		return err
+		return duplicateKey(err)
 	}
 	var affected int64
 	affected, err = result.RowsAffected()
@@ -142,20 +212,18 @@ func (repo *SQLProfessorRepository) Update(cfg *professors.Professor) error {
 	if !(affected > 0) {
 		return ErrZeroRowsAffected
 	}
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "professor updated")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor updated")
 	return nil
 }
 
-func (repo *SQLProfessorRepository) DeleteByID(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+func (repo *SQLProfessorRepository) DeleteByID(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
 	defer cancel()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
 	stmt, err := repo.db.school.PrepareContext(ctx, "delete from professors where id=?;")
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
 	var result sql.Result
 	result, err = stmt.ExecContext(ctx, id)
 	if err != nil {
@@ -169,6 +237,6 @@ func (repo *SQLProfessorRepository) DeleteByID(id string) error {
 	if !(affected > 0) {
 		return ErrZeroRowsAffected
 	}
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "professor deleted")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor deleted")
 	return nil
 }
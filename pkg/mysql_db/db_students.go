@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/xHappyface/school/api/pagination"
 	"github.com/xHappyface/school/api/students"
 	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/internal/sqldb"
 )
 
 type SQLStudentRepository struct {
@@ -23,21 +25,22 @@ func NewSQLStudentRepository(db *School, milliseconds uint, l *logger.SchoolLogg
 	}
 }
 
-func (repo *SQLStudentRepository) Create(cfg *students.Student) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+func (repo *SQLStudentRepository) Create(ctx context.Context, cfg *students.Student) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
 	defer cancel()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
 	stmt, err := repo.db.school.PrepareContext(ctx, `insert into students(id, name, age, address, phone, if_international, if_on_probation)
 										values (?, ?, ?, ?, ?, ?, ?);`)
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
 	var result sql.Result
-	result, err = stmt.ExecContext(ctx, cfg.ID)
+	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name, cfg.Age, cfg.Address, cfg.Phone, cfg.IfInternational, cfg.IfOnProbation)
 	if err != nil {
-		return err
+		return duplicateKey(err)
 	}
 	var affected int64
 	affected, err = result.RowsAffected()
@@ -47,27 +50,25 @@ func (repo *SQLStudentRepository) Create(cfg *students.Student) error {
 	if !(affected > 0) {
 		return ErrZeroRowsAffected
 	}
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "student created")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student created")
 	return nil
 }
 
-func (repo *SQLStudentRepository) ReadByID(id string) (*students.Student, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+func (repo *SQLStudentRepository) ReadByID(ctx context.Context, id string) (*students.Student, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
 	defer cancel()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
-	stmt, err := repo.db.school.PrepareContext(ctx, "select * from students where id=?;")
+	stmt, err := repo.db.school.PrepareContext(ctx, "select id, name, age, address, phone, if_international, if_on_probation from students where id=?;")
 	if err != nil {
 		return new(students.Student), err
 	}
 	defer stmt.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
-	var rows *sql.Rows
+	var rows *Rows
 	rows, err = stmt.QueryContext(ctx, id)
 	if err != nil {
 		return new(students.Student), err
 	}
 	defer rows.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "scanning rows...")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
 	student := new(students.Student)
 	for rows.Next() {
 		err = rows.Scan(&student.ID, &student.Name, &student.Age, &student.Address, &student.Phone, &student.IfInternational, &student.IfOnProbation)
@@ -81,27 +82,25 @@ func (repo *SQLStudentRepository) ReadByID(id string) (*students.Student, error)
 	if student.ID == "" {
 		return new(students.Student), ErrZeroRowsRetrieved
 	}
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "student retrieved")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student retrieved")
 	return student, nil
 }
 
-func (repo *SQLStudentRepository) ReadByName(name string) (*students.Student, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+func (repo *SQLStudentRepository) ReadByName(ctx context.Context, name string) (*students.Student, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
 	defer cancel()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
-	stmt, err := repo.db.school.PrepareContext(ctx, "select * from students where name=?;")
+	stmt, err := repo.db.school.PrepareContext(ctx, "select id, name, age, address, phone, if_international, if_on_probation from students where name=?;")
 	if err != nil {
 		return new(students.Student), err
 	}
 	defer stmt.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
-	var rows *sql.Rows
+	var rows *Rows
 	rows, err = stmt.QueryContext(ctx, name)
 	if err != nil {
 		return new(students.Student), err
 	}
 	defer rows.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "scanning rows...")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
 	student := new(students.Student)
 	for rows.Next() {
 		err = rows.Scan(&student.ID, &student.Name, &student.Age, &student.Address, &student.Phone, &student.IfInternational, &student.IfOnProbation)
@@ -115,24 +114,92 @@ func (repo *SQLStudentRepository) ReadByName(name string) (*students.Student, er
 	if student.ID == "" {
 		return new(students.Student), ErrZeroRowsRetrieved
 	}
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "student retrieved")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student retrieved")
 	return student, nil
 }
 
-func (repo *SQLStudentRepository) Update(cfg *students.Student) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+// List returns one page of the students matching filter and the cursor of the next page, empty on the last page.
+func (repo *SQLStudentRepository) List(ctx context.Context, filter *students.Filter, page *pagination.Page) ([]*students.Student, string, error) {
+	sort, err := page.SortKey(pagination.SORT_NAME, pagination.SORT_AGE)
+	if err != nil {
+		return []*students.Student{}, "", err
+	}
+	after, err := page.After()
+	if err != nil {
+		return []*students.Student{}, "", err
+	}
+	q := sqldb.NewListQuery(sqldb.PLACEHOLDER_QUESTION)
+	q.NamePrefix(filter.NamePrefix)
+	if filter.MinAge > 0 {
+		q.Where("age >= ?", filter.MinAge)
+	}
+	if filter.MaxAge > 0 {
+		q.Where("age <= ?", filter.MaxAge)
+	}
+	if filter.IfInternational != nil {
+		q.Where("if_international = ?", *filter.IfInternational)
+	}
+	if filter.IfOnProbation != nil {
+		q.Where("if_on_probation = ?", *filter.IfOnProbation)
+	}
+	if after != nil {
+		var value any
+		if value, err = after.Value(sort); err != nil {
+			return []*students.Student{}, "", err
+		}
+		q.After(sort, value, after.ID)
+	}
+	query, args := q.Build("select id, name, age, address, phone, if_international, if_on_probation from students", sort, page.Size()+1)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
 	defer cancel()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
-	stmt, err := repo.db.school.PrepareContext(ctx, "update student set id=?, name=?, age=?, address=?, phone=?, if_on_probation=? where id=?;")
+	stmt, err := repo.db.school.PrepareContext(ctx, query)
+	if err != nil {
+		return []*students.Student{}, "", err
+	}
+	defer stmt.Close()
+	var rows *Rows
+	rows, err = stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return []*students.Student{}, "", err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*students.Student{}
+	for rows.Next() {
+		student := new(students.Student)
+		err = rows.Scan(&student.ID, &student.Name, &student.Age, &student.Address, &student.Phone, &student.IfInternational, &student.IfOnProbation)
+		if err != nil {
+			return []*students.Student{}, "", err
+		}
+		list = append(list, student)
+	}
+	if err = rows.Err(); err != nil {
+		return []*students.Student{}, "", err
+	}
+	var next string
+	if uint(len(list)) > page.Size() {
+		list = list[:page.Size()]
+		next = list[len(list)-1].Cursor(sort).String()
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "students retrieved")
+	return list, next, nil
+}
+
+func (repo *SQLStudentRepository) Update(ctx context.Context, cfg *students.Student) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	stmt, err := repo.db.school.PrepareContext(ctx, "update students set id=?, name=?, age=?, address=?, phone=?, if_international=?, if_on_probation=? where id=?;")
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
 	var result sql.Result
-	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name, cfg.Age, cfg.Address, cfg.Phone, cfg.IfOnProbation, cfg.ID)
+	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name, cfg.Age, cfg.Address, cfg.Phone, cfg.IfInternational, cfg.IfOnProbation, cfg.ID)
 	if err != nil {
-		return err
+		return duplicateKey(err)
 	}
 	var affected int64
 	affected, err = result.RowsAffected()
@@ -142,20 +209,18 @@ func (repo *SQLStudentRepository) Update(cfg *students.Student) error {
 	if !(affected > 0) {
 		return ErrZeroRowsAffected
 	}
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "student updated")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student updated")
 	return nil
 }
 
-func (repo *SQLStudentRepository) DeleteByID(id string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+func (repo *SQLStudentRepository) DeleteByID(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
 	defer cancel()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
 	stmt, err := repo.db.school.PrepareContext(ctx, "delete from students where id=?;")
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
-	repo.logger.Log(logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
 	var result sql.Result
 	result, err = stmt.ExecContext(ctx, id)
 	if err != nil {
@@ -169,6 +234,6 @@ func (repo *SQLStudentRepository) DeleteByID(id string) error {
 	if !(affected > 0) {
 		return ErrZeroRowsAffected
 	}
-	repo.logger.Log(logger.LOG_LEVEL_INFO, "student deleted")
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student deleted")
 	return nil
 }
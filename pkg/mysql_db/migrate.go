@@ -0,0 +1,322 @@
+package mysql_db
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/xHappyface/school/logger"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+var (
+	ErrSchemaOutOfDate    = errors.New("database schema is out of date")
+	ErrNoMigrationApplied = errors.New("no migration applied")
+
+	errInvalidMigrationName = errors.New("invalid migration file name")
+)
+
+// MySQL error numbers of statements whose change was already made,
+// met when rerunning a migration that failed halfway.
+const (
+	ER_DUP_FIELDNAME          uint16 = 1060
+	ER_DUP_KEYNAME            uint16 = 1061
+	ER_CANT_DROP_FIELD_OR_KEY uint16 = 1091
+)
+
+// Migration is one embedded schema change, read from migrations/<version>_<name>.<up|down>.sql.
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Version   uint
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	db                  *School
+	ctxTimeMilliseconds uint
+	logger              *logger.SchoolLogger
+}
+
+func NewMigrator(db *School, milliseconds uint, l *logger.SchoolLogger) *Migrator {
+	return &Migrator{
+		db:                  db,
+		ctxTimeMilliseconds: milliseconds,
+		logger:              l,
+	}
+}
+
+// Migrations returns the embedded migrations ordered by version.
+func Migrations() ([]*Migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return []*Migration{}, err
+	}
+	byVersion := make(map[uint]*Migration)
+	for _, entry := range entries {
+		name := strings.TrimSuffix(entry.Name(), ".sql")
+		direction := path.Ext(name)
+		name = strings.TrimSuffix(name, direction)
+		version, label, ok := strings.Cut(name, "_")
+		if !ok || !(direction == ".up" || direction == ".down") {
+			return []*Migration{}, fmt.Errorf("%w: %s", errInvalidMigrationName, entry.Name())
+		}
+		var v uint64
+		v, err = strconv.ParseUint(version, 10, 32)
+		if err != nil {
+			return []*Migration{}, fmt.Errorf("%w: %s", errInvalidMigrationName, entry.Name())
+		}
+		var body []byte
+		body, err = migrationFiles.ReadFile(path.Join("migrations", entry.Name()))
+		if err != nil {
+			return []*Migration{}, err
+		}
+		m, ok := byVersion[uint(v)]
+		if !ok {
+			m = &Migration{Version: uint(v), Name: label}
+			byVersion[uint(v)] = m
+		}
+		if direction == ".up" {
+			m.Up = string(body)
+		} else {
+			m.Down = string(body)
+		}
+	}
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Up applies every pending migration in order.
+//
+// Each migration runs in one transaction with the insert recording its version.
+// MySQL commits implicitly around DDL such as create table though, so a migration
+// failing halfway can leave its first statements applied and its version unrecorded.
+// Migrations are written to be rerun then: tables are created and dropped with
+// if (not) exists, and errors reporting a change already made are ignored.
+func (m *Migrator) Up(ctx context.Context) error {
+	migrations, err := Migrations()
+	if err != nil {
+		return err
+	}
+	var applied map[uint]time.Time
+	applied, err = m.applied(ctx)
+	if err != nil {
+		return err
+	}
+	for _, migration := range migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		m.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, fmt.Sprintf("applying migration %04d_%s...", migration.Version, migration.Name))
+		err = m.migrate(ctx, migration.Up, "insert into schema_migrations(version, name) values (?, ?);", migration.Version, migration.Name)
+		if err != nil {
+			return err
+		}
+	}
+	m.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "schema up to date")
+	return nil
+}
+
+// Down reverts the most recently applied migration, in a transaction as Up does.
+func (m *Migrator) Down(ctx context.Context) error {
+	migrations, err := Migrations()
+	if err != nil {
+		return err
+	}
+	var applied map[uint]time.Time
+	applied, err = m.applied(ctx)
+	if err != nil {
+		return err
+	}
+	for i := len(migrations) - 1; i >= 0; i-- {
+		migration := migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		m.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, fmt.Sprintf("reverting migration %04d_%s...", migration.Version, migration.Name))
+		return m.migrate(ctx, migration.Down, "delete from schema_migrations where version=?;", migration.Version)
+	}
+	return ErrNoMigrationApplied
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return []*MigrationStatus{}, err
+	}
+	var applied map[uint]time.Time
+	applied, err = m.applied(ctx)
+	if err != nil {
+		return []*MigrationStatus{}, err
+	}
+	statuses := make([]*MigrationStatus, 0, len(migrations))
+	for _, migration := range migrations {
+		at, ok := applied[migration.Version]
+		statuses = append(statuses, &MigrationStatus{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			Applied:   ok,
+			AppliedAt: at,
+		})
+	}
+	return statuses, nil
+}
+
+// CheckCurrent returns ErrSchemaOutOfDate unless every embedded migration has been applied.
+func (m *Migrator) CheckCurrent(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	for _, status := range statuses {
+		if !(status.Applied) {
+			return ErrSchemaOutOfDate
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[uint]time.Time, error) {
+	err := m.exec(ctx, `create table if not exists schema_migrations (
+		version    int unsigned not null primary key,
+		name       varchar(255) not null,
+		applied_at timestamp    not null default current_timestamp
+	);`)
+	if err != nil {
+		return map[uint]time.Time{}, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(m.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	rows, err := m.db.school.QueryContext(ctx, "select version, applied_at from schema_migrations;")
+	if err != nil {
+		return map[uint]time.Time{}, err
+	}
+	defer rows.Close()
+	applied := make(map[uint]time.Time)
+	for rows.Next() {
+		var version uint
+		var at []byte
+		if err = rows.Scan(&version, &at); err != nil {
+			return map[uint]time.Time{}, err
+		}
+		applied[version], _ = time.Parse(time.DateTime, string(at))
+	}
+	if err = rows.Err(); err != nil {
+		return map[uint]time.Time{}, err
+	}
+	return applied, nil
+}
+
+// migrate runs the statements of body, then record with args, in one transaction.
+func (m *Migrator) migrate(ctx context.Context, body string, record string, args ...any) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(m.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	tx, err := m.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range splitStatements(body) {
+		if _, err = tx.school.ExecContext(ctx, stmt); err != nil && !(alreadyApplied(err)) {
+			tx.Rollback()
+			return err
+		}
+	}
+	if _, err = tx.school.ExecContext(ctx, record, args...); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (m *Migrator) exec(ctx context.Context, query string, args ...any) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(m.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	_, err := m.db.school.ExecContext(ctx, query, args...)
+	return err
+}
+
+// alreadyApplied reports whether err is MySQL refusing a change already made.
+func alreadyApplied(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if !(errors.As(err, &mysqlErr)) {
+		return false
+	}
+	switch mysqlErr.Number {
+	case ER_DUP_FIELDNAME, ER_DUP_KEYNAME, ER_CANT_DROP_FIELD_OR_KEY:
+		return true
+	default:
+		return false
+	}
+}
+
+// splitStatements splits a migration body into its ';'-terminated statements,
+// since the driver does not accept several in one call. A ';' inside quotes
+// or comments does not end a statement, and comments are dropped.
+func splitStatements(body string) []string {
+	statements := []string{}
+	stmt := new(strings.Builder)
+	end := func() {
+		if text := strings.TrimSpace(stmt.String()); len(text) > 0 {
+			statements = append(statements, text)
+		}
+		stmt.Reset()
+	}
+	runes := []rune(body)
+	// quote is the open quote character, 0 outside of quotes.
+	var quote rune
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		switch {
+		case quote != 0:
+			stmt.WriteRune(c)
+			if c == '\\' && quote != '`' && i+1 < len(runes) {
+				i++
+				stmt.WriteRune(runes[i])
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+			stmt.WriteRune(c)
+		case c == '#' || (c == '-' && i+1 < len(runes) && runes[i+1] == '-' && (i+2 == len(runes) || unicode.IsSpace(runes[i+2]))):
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i+1 < len(runes) && !(runes[i] == '*' && runes[i+1] == '/') {
+				i++
+			}
+			i++
+			stmt.WriteRune(' ')
+		case c == ';':
+			end()
+		default:
+			stmt.WriteRune(c)
+		}
+	}
+	end()
+	return statements
+}
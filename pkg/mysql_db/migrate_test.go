@@ -0,0 +1,43 @@
+package mysql_db
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{"one", "create table t (id int);\n", []string{"create table t (id int)"}},
+		{"several", "drop table a;\ndrop table b;", []string{"drop table a", "drop table b"}},
+		{"unterminated", "drop table a", []string{"drop table a"}},
+		{"quoted", `insert into t values ('a;b', "c;d", 'e\';f');`, []string{`insert into t values ('a;b', "c;d", 'e\';f')`}},
+		{"backticks", "alter table `a;b` drop c;", []string{"alter table `a;b` drop c"}},
+		{"line comments", "-- first; \ndrop table a; # second;\n", []string{"drop table a"}},
+		{"block comment", "drop /* a; */ table b;", []string{"drop   table b"}},
+		{"double dash", "select 1--1;", []string{"select 1--1"}},
+		{"comments only", "-- nothing here;\n/* nor; here */", []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := splitStatements(test.body); !(reflect.DeepEqual(got, test.want)) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMigrationsSplit(t *testing.T) {
+	migrations, err := Migrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, migration := range migrations {
+		if len(splitStatements(migration.Up)) == 0 || len(splitStatements(migration.Down)) == 0 {
+			t.Errorf("migration %04d_%s has an empty direction", migration.Version, migration.Name)
+		}
+	}
+}
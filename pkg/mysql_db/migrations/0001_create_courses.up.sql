@@ -0,0 +1,4 @@
+create table if not exists courses (
+	id   char(36)     not null primary key,
+	name varchar(255) not null
+);
@@ -0,0 +1,9 @@
+create table if not exists professors (
+	id                char(36)         not null primary key,
+	name              varchar(255)     not null,
+	age               tinyint unsigned not null,
+	address           varchar(255)     not null,
+	phone             bigint unsigned  not null,
+	salary            double           not null,
+	if_received_bonus boolean          not null default false
+);
@@ -0,0 +1,9 @@
+create table if not exists students (
+	id               char(36)         not null primary key,
+	name             varchar(255)     not null,
+	age              tinyint unsigned not null,
+	address          varchar(255)     not null,
+	phone            bigint unsigned  not null,
+	if_international boolean          not null default false,
+	if_on_probation  boolean          not null default false
+);
@@ -0,0 +1,7 @@
+create table if not exists enrollments (
+	student_id char(36) not null,
+	course_id  char(36) not null,
+	primary key (student_id, course_id),
+	foreign key (student_id) references students(id) on delete cascade,
+	foreign key (course_id) references courses(id) on delete cascade
+);
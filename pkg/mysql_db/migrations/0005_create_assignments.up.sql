@@ -0,0 +1,8 @@
+create table if not exists assignments (
+	professor_id char(36)    not null,
+	course_id    char(36)    not null,
+	role         varchar(16) not null,
+	primary key (professor_id, course_id),
+	foreign key (professor_id) references professors(id) on delete cascade,
+	foreign key (course_id) references courses(id) on delete cascade
+);
@@ -0,0 +1,3 @@
+alter table students drop index students_name_unique;
+alter table professors drop index professors_name_unique;
+alter table courses drop index courses_name_unique;
@@ -0,0 +1,3 @@
+alter table courses add unique key courses_name_unique (name);
+alter table professors add unique key professors_name_unique (name);
+alter table students add unique key students_name_unique (name);
@@ -6,39 +6,61 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/xHappyface/school/logger"
-
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/xHappyface/school/pkg/internal/sqldb"
 )
 
-const (
-	LOG_PREPARING_STMT = "preparing sql statement..."
-	LOG_EXECUTING_STMT = "executing sql statement..."
-)
+// ER_DUP_ENTRY is the MySQL error number of a row violating a primary key or unique index.
+const ER_DUP_ENTRY uint16 = 1062
 
 var (
 	ErrZeroRowsAffected  = errors.New("zero rows affected")
 	ErrZeroRowsRetrieved = errors.New("zero rows retrieved")
+	// ErrDuplicateKey is a row refused by a primary key or unique index, as when a
+	// concurrent statement stored the same name between a check and an insert.
+	ErrDuplicateKey    = errors.New("duplicate key")
+	ErrNoTransaction   = sqldb.ErrNoTransaction
+	ErrTransactionOpen = sqldb.ErrTransactionOpen
 )
 
+// School runs the statements of the repositories built on it through school,
+// which traces them on the connection pool or, once begun, a transaction.
 type School struct {
-	school *sql.DB
+	conn   *sqldb.Conn
+	school *tracer
 }
 
-func NewSchoolDB(l *logger.SchoolLogger) (*School, error) {
-	db, err := connect(l)
+// NewSchoolDB connects to the database source names, warning of statements slower than DB_SLOW_QUERY_MS.
+// source is a go-sql-driver/mysql data source name, empty for the local school database as DB_USER with DB_PASS.
+func NewSchoolDB(l *logger.SchoolLogger, source string) (*School, error) {
+	slow, err := slowQueryFromEnv()
+	if err != nil {
+		return new(School), err
+	}
+	db, err := connect(l, source)
 	if err != nil {
 		return new(School), err
 	}
-	return &School{school: db}, nil
+	return &School{conn: sqldb.NewConn(db), school: &tracer{conn: db, logger: l, slow: slow}}, nil
 }
 
-func connect(l *logger.SchoolLogger) (*sql.DB, error) {
+func connect(l *logger.SchoolLogger, source string) (*sql.DB, error) {
 	l.Log(logger.LOG_LEVEL_INFO, "connecting to sql database...")
-	user := os.Getenv("DB_USER")
-	pass := os.Getenv("DB_PASS")
-	source := fmt.Sprintf("%s:%s@tcp(localhost:3306)/school", user, pass)
-	db, err := sql.Open("mysql", source)
+	cfg := mysql.NewConfig()
+	if source == "" {
+		cfg.User, cfg.Passwd = os.Getenv("DB_USER"), os.Getenv("DB_PASS")
+		cfg.Net, cfg.Addr, cfg.DBName = "tcp", "localhost:3306", "school"
+	} else {
+		var err error
+		if cfg, err = mysql.ParseDSN(source); err != nil {
+			return new(sql.DB), err
+		}
+	}
+	// report the rows an update matches rather than those it changes, so that
+	// saving unchanged values is not mistaken for a missing row
+	cfg.ClientFoundRows = true
+	db, err := sql.Open("mysql", cfg.FormatDSN())
 	if err != nil {
 		return new(sql.DB), err
 	}
@@ -49,6 +71,16 @@ func connect(l *logger.SchoolLogger) (*sql.DB, error) {
 	return db, nil
 }
 
+// Close closes the connection pool, a School bound to a transaction leaves that to the School it began from.
 func (schoolDB *School) Close() error {
-	return schoolDB.school.Close()
+	return schoolDB.conn.Close()
+}
+
+// duplicateKey wraps a primary key or unique index violation in ErrDuplicateKey, leaving other errors as they are.
+func duplicateKey(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == ER_DUP_ENTRY {
+		return fmt.Errorf("%w: %s", ErrDuplicateKey, err)
+	}
+	return err
 }
@@ -0,0 +1,157 @@
+package mysql_db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/internal/sqldb"
+)
+
+const DEFAULT_SLOW_QUERY = 200 * time.Millisecond
+
+var ErrInvalidSlowQuery = errors.New("invalid DB_SLOW_QUERY_MS, expected a non-negative number of milliseconds")
+
+// tracer runs statements on conn, logging the query text, parameter count, rows
+// affected or returned and duration of each, as a warning when it took slow or longer.
+// A zero slow never warns.
+type tracer struct {
+	conn   sqldb.Querier
+	logger *logger.SchoolLogger
+	slow   time.Duration
+}
+
+// slowQueryFromEnv reads the slow query threshold from DB_SLOW_QUERY_MS, DEFAULT_SLOW_QUERY when unset.
+func slowQueryFromEnv() (time.Duration, error) {
+	value := os.Getenv("DB_SLOW_QUERY_MS")
+	if value == "" {
+		return DEFAULT_SLOW_QUERY, nil
+	}
+	ms, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidSlowQuery, value)
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+func (t *tracer) PrepareContext(ctx context.Context, query string) (*Stmt, error) {
+	stmt, err := t.conn.PrepareContext(ctx, query)
+	if err != nil {
+		return new(Stmt), err
+	}
+	return &Stmt{stmt: stmt, query: query, tracer: t}, nil
+}
+
+func (t *tracer) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	start := time.Now()
+	result, err := t.conn.ExecContext(ctx, query, args...)
+	t.traceExec(ctx, query, len(args), start, result, err)
+	return result, err
+}
+
+func (t *tracer) QueryContext(ctx context.Context, query string, args ...any) (*Rows, error) {
+	start := time.Now()
+	rows, err := t.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		t.trace(ctx, query, len(args), time.Since(start), err, "rows_returned", 0)
+		return new(Rows), err
+	}
+	return &Rows{Rows: rows, ctx: ctx, query: query, params: len(args), start: start, tracer: t}, nil
+}
+
+func (t *tracer) traceExec(ctx context.Context, query string, params int, start time.Time, result sql.Result, err error) {
+	duration := time.Since(start)
+	var affected int64
+	if err == nil {
+		affected, _ = result.RowsAffected()
+	}
+	t.trace(ctx, query, params, duration, err, "rows_affected", affected)
+}
+
+// trace logs one statement with the correlation id of ctx, rowsKey naming whether rows were affected or returned.
+func (t *tracer) trace(ctx context.Context, query string, params int, duration time.Duration, err error, rowsKey string, rows int64) {
+	kv := []any{"query", strings.Join(strings.Fields(query), " "), "params", params, rowsKey, rows, "duration", duration}
+	if err != nil {
+		kv = append(kv, "error", err)
+	}
+	if t.slow > 0 && duration >= t.slow {
+		t.logger.LogContext(ctx, logger.LOG_LEVEL_WRN, "slow sql statement", append(kv, "threshold", t.slow)...)
+		return
+	}
+	t.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "sql statement executed", kv...)
+}
+
+// Stmt is a prepared statement whose executions are traced.
+type Stmt struct {
+	stmt   *sql.Stmt
+	query  string
+	tracer *tracer
+}
+
+func (s *Stmt) ExecContext(ctx context.Context, args ...any) (sql.Result, error) {
+	start := time.Now()
+	result, err := s.stmt.ExecContext(ctx, args...)
+	s.tracer.traceExec(ctx, s.query, len(args), start, result, err)
+	return result, err
+}
+
+func (s *Stmt) QueryContext(ctx context.Context, args ...any) (*Rows, error) {
+	start := time.Now()
+	rows, err := s.stmt.QueryContext(ctx, args...)
+	if err != nil {
+		s.tracer.trace(ctx, s.query, len(args), time.Since(start), err, "rows_returned", 0)
+		return new(Rows), err
+	}
+	return &Rows{Rows: rows, ctx: ctx, query: s.query, params: len(args), start: start, tracer: s.tracer}, nil
+}
+
+func (s *Stmt) Close() error {
+	if s.stmt == nil {
+		return nil
+	}
+	return s.stmt.Close()
+}
+
+// Rows counts the rows read from a query, which is traced once they are exhausted or closed.
+type Rows struct {
+	*sql.Rows
+	ctx      context.Context
+	query    string
+	params   int
+	start    time.Time
+	tracer   *tracer
+	count    int64
+	finished bool
+}
+
+func (r *Rows) Next() bool {
+	if r.Rows.Next() {
+		r.count++
+		return true
+	}
+	r.finish()
+	return false
+}
+
+func (r *Rows) Close() error {
+	if r.Rows == nil {
+		return nil
+	}
+	err := r.Rows.Close()
+	r.finish()
+	return err
+}
+
+func (r *Rows) finish() {
+	if r.finished {
+		return
+	}
+	r.finished = true
+	r.tracer.trace(r.ctx, r.query, r.params, time.Since(r.start), r.Rows.Err(), "rows_returned", r.count)
+}
@@ -0,0 +1,22 @@
+package mysql_db
+
+import "context"
+
+// Begin starts a transaction and returns a School bound to it,
+// repositories built on the returned School run inside the transaction.
+func (schoolDB *School) Begin(ctx context.Context) (*School, error) {
+	conn, err := schoolDB.conn.Begin(ctx)
+	if err != nil {
+		return new(School), err
+	}
+	traced := &tracer{conn: conn.Querier(), logger: schoolDB.school.logger, slow: schoolDB.school.slow}
+	return &School{conn: conn, school: traced}, nil
+}
+
+func (schoolDB *School) Commit() error {
+	return schoolDB.conn.Commit()
+}
+
+func (schoolDB *School) Rollback() error {
+	return schoolDB.conn.Rollback()
+}
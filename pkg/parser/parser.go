@@ -0,0 +1,216 @@
+// Package parser splits the text typed into the cli into statements.
+//
+// A statement is a list of words ended by `;`, it may span several lines
+// and one line may hold several statements. A word is a run of characters
+// other than whitespace, `;` and quotes, joined to any quoted strings it
+// touches, so `name="MATH 101"` is the single word `name=MATH 101`.
+// Single and double quoted strings keep their spaces and case, and inside
+// them a backslash escapes the next character (`\n` and `\t` included).
+// Outside of quotes `--` starts a comment running to the end of the line.
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	msgUnterminatedQuote = "unterminated %c-quoted string"
+	msgInvalidEscape     = "invalid escape sequence \\%c"
+	msgUnterminated      = "statement not terminated by ;"
+)
+
+// Position is the 1-based line and column of a character in the input.
+type Position struct {
+	Line   int
+	Column int
+}
+
+func (pos Position) String() string {
+	return fmt.Sprintf("line %d, column %d", pos.Line, pos.Column)
+}
+
+// SyntaxError reports where the input stopped following the grammar.
+type SyntaxError struct {
+	Pos Position
+	Msg string
+}
+
+func (err *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at %s: %s", err.Pos, err.Msg)
+}
+
+type Word struct {
+	Value string
+	Pos   Position
+	// Quoted is true when any part of the word was quoted.
+	Quoted bool
+}
+
+type Statement struct {
+	Words []Word
+	Pos   Position
+}
+
+// Args returns the values of the statement's words.
+func (stmt *Statement) Args() []string {
+	args := make([]string, 0, len(stmt.Words))
+	for _, word := range stmt.Words {
+		args = append(args, word.Value)
+	}
+	return args
+}
+
+// Parser reads the input one line at a time, carrying unfinished statements
+// and quoted strings over to the next line.
+type Parser struct {
+	line int
+
+	stmt   *Statement
+	word   *strings.Builder
+	inWord bool
+	// quote is the open quote character, 0 outside of quoted strings.
+	quote    rune
+	quotePos Position
+	wordPos  Position
+	quoted   bool
+	// continued is true when the line ended in a backslash inside quotes.
+	continued bool
+}
+
+func NewParser() *Parser {
+	return &Parser{word: new(strings.Builder)}
+}
+
+// Pending reports whether a statement has been started but not yet ended by `;`.
+func (p *Parser) Pending() bool {
+	return p.stmt != nil || p.inWord || p.quote != 0
+}
+
+// SetLine numbers the next line parsed n, for input whose lines are not all
+// statements, such as answers to prompts read by something else.
+func (p *Parser) SetLine(n int) {
+	p.line = n - 1
+}
+
+// Parse reads line and returns the statements it completes. After a syntax
+// error the rest of the line and the statement it occurred in are discarded,
+// the statements completed before it are still returned.
+func (p *Parser) Parse(line string) ([]*Statement, error) {
+	p.line++
+	p.continued = false
+	statements := []*Statement{}
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		pos := Position{Line: p.line, Column: i + 1}
+		if p.quote != 0 {
+			switch {
+			case c == p.quote:
+				p.quote = 0
+			case c == '\\':
+				if i+1 == len(runes) {
+					// a backslash ending the line escapes the line break
+					p.continued = true
+					continue
+				}
+				i++
+				escaped, ok := unescape(runes[i])
+				if !ok {
+					err := &SyntaxError{Pos: pos, Msg: fmt.Sprintf(msgInvalidEscape, runes[i])}
+					p.reset()
+					return statements, err
+				}
+				p.word.WriteRune(escaped)
+			default:
+				p.word.WriteRune(c)
+			}
+			continue
+		}
+		switch {
+		case c == ';':
+			p.endWord()
+			if p.stmt != nil {
+				statements = append(statements, p.stmt)
+			}
+			p.stmt = nil
+		case c == ' ' || c == '\t' || c == '\r':
+			p.endWord()
+		case c == '-' && !(p.inWord) && i+1 < len(runes) && runes[i+1] == '-':
+			i = len(runes)
+		case c == '\'' || c == '"':
+			p.startWord(pos)
+			p.quote = c
+			p.quotePos = pos
+			p.quoted = true
+		default:
+			p.startWord(pos)
+			p.word.WriteRune(c)
+		}
+	}
+	if p.quote != 0 {
+		// quoted strings keep their line breaks unless escaped
+		if !(p.continued) {
+			p.word.WriteRune('\n')
+		}
+	} else {
+		p.endWord()
+	}
+	return statements, nil
+}
+
+// Close ends the input, returning a SyntaxError if it stopped inside a statement.
+func (p *Parser) Close() error {
+	defer p.reset()
+	if p.quote != 0 {
+		return &SyntaxError{Pos: p.quotePos, Msg: fmt.Sprintf(msgUnterminatedQuote, p.quote)}
+	}
+	if p.stmt != nil {
+		return &SyntaxError{Pos: p.stmt.Pos, Msg: msgUnterminated}
+	}
+	return nil
+}
+
+func (p *Parser) startWord(pos Position) {
+	if p.inWord {
+		return
+	}
+	if p.stmt == nil {
+		p.stmt = &Statement{Pos: pos}
+	}
+	p.inWord = true
+	p.wordPos = pos
+}
+
+func (p *Parser) endWord() {
+	if !(p.inWord) {
+		return
+	}
+	p.stmt.Words = append(p.stmt.Words, Word{Value: p.word.String(), Pos: p.wordPos, Quoted: p.quoted})
+	p.word.Reset()
+	p.inWord = false
+	p.quoted = false
+}
+
+// reset drops the statement being read.
+func (p *Parser) reset() {
+	p.stmt = nil
+	p.word.Reset()
+	p.inWord = false
+	p.quote = 0
+	p.quoted = false
+	p.continued = false
+}
+
+func unescape(c rune) (rune, bool) {
+	switch c {
+	case 'n':
+		return '\n', true
+	case 't':
+		return '\t', true
+	case '\\', '\'', '"':
+		return c, true
+	default:
+		return 0, false
+	}
+}
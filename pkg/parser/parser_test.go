@@ -0,0 +1,167 @@
+package parser
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// parseAll feeds lines to a new parser and returns the arguments of every
+// statement completed, failing the test on any syntax error.
+func parseAll(t *testing.T, lines ...string) [][]string {
+	t.Helper()
+	p := NewParser()
+	args := [][]string{}
+	for _, line := range lines {
+		statements, err := p.Parse(line)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", line, err)
+		}
+		for _, stmt := range statements {
+			args = append(args, stmt.Args())
+		}
+	}
+	if err := p.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	return args
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  [][]string
+	}{
+		{"words", []string{"new student;"}, [][]string{{"new", "student"}}},
+		{"several statements", []string{"begin; commit;"}, [][]string{{"begin"}, {"commit"}}},
+		{"empty statements", []string{";; list courses;"}, [][]string{{"list", "courses"}}},
+		{"spanning lines", []string{"list", "courses", ";"}, [][]string{{"list", "courses"}}},
+		{"double quotes", []string{`new course name="MATH 101";`}, [][]string{{"new", "course", "name=MATH 101"}}},
+		{"single quotes", []string{`x 'a "b" c';`}, [][]string{{"x", `a "b" c`}}},
+		{"quoted semicolon", []string{`x "a;b";`}, [][]string{{"x", "a;b"}}},
+		{"empty quotes", []string{`x "";`}, [][]string{{"x", ""}}},
+		{"escapes", []string{`x "a\"b\\c\n\t'";`}, [][]string{{"x", "a\"b\\c\n\t'"}}},
+		{"quoted line break", []string{`x "AB`, `CD";`}, [][]string{{"x", "AB\nCD"}}},
+		{"escaped line break", []string{`x "AB\`, `CD";`}, [][]string{{"x", "ABCD"}}},
+		{"escaped line break twice", []string{`x "A\`, `B\`, `C";`}, [][]string{{"x", "ABC"}}},
+		{"comment", []string{"list courses; -- ; x;"}, [][]string{{"list", "courses"}}},
+		{"comment mid statement", []string{"list -- the courses", "courses;"}, [][]string{{"list", "courses"}}},
+		{"dashes in word", []string{"x a--b;"}, [][]string{{"x", "a--b"}}},
+		{"quoted dashes", []string{`x "--";`}, [][]string{{"x", "--"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseAll(t, test.lines...)
+			if !(reflect.DeepEqual(got, test.want)) {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseQuoted(t *testing.T) {
+	p := NewParser()
+	statements, err := p.Parse(`x a "b"c;`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	words := statements[0].Words
+	if words[1].Quoted || !(words[2].Quoted) {
+		t.Errorf("got Quoted %t, %t, want false, true", words[1].Quoted, words[2].Quoted)
+	}
+}
+
+func TestParsePositions(t *testing.T) {
+	p := NewParser()
+	if _, err := p.Parse("-- comment"); err != nil {
+		t.Fatal(err)
+	}
+	statements, err := p.Parse("  new\tstudent;  list")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statements) != 1 {
+		t.Fatalf("got %d statements, want 1", len(statements))
+	}
+	stmt := statements[0]
+	if want := (Position{Line: 2, Column: 3}); stmt.Pos != want {
+		t.Errorf("statement at %s, want %s", stmt.Pos, want)
+	}
+	if want := (Position{Line: 2, Column: 7}); stmt.Words[1].Pos != want {
+		t.Errorf("word at %s, want %s", stmt.Words[1].Pos, want)
+	}
+	statements, err = p.Parse("courses;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Position{Line: 2, Column: 17}); statements[0].Pos != want {
+		t.Errorf("statement at %s, want %s", statements[0].Pos, want)
+	}
+	if want := (Position{Line: 3, Column: 1}); statements[0].Words[1].Pos != want {
+		t.Errorf("word at %s, want %s", statements[0].Words[1].Pos, want)
+	}
+}
+
+func TestSetLine(t *testing.T) {
+	p := NewParser()
+	p.SetLine(5)
+	statements, err := p.Parse("x;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statements[0].Pos.Line != 5 {
+		t.Errorf("statement on line %d, want 5", statements[0].Pos.Line)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  Position
+	}{
+		{"invalid escape", []string{`x "a\q";`}, Position{Line: 1, Column: 5}},
+		{"unterminated quote", []string{`x 'abc`, ""}, Position{Line: 1, Column: 3}},
+		{"unterminated statement", []string{"", "  list courses"}, Position{Line: 2, Column: 3}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := NewParser()
+			var err error
+			for _, line := range test.lines {
+				if _, err = p.Parse(line); err != nil {
+					break
+				}
+			}
+			if err == nil {
+				err = p.Close()
+			}
+			var syntaxErr *SyntaxError
+			if !(errors.As(err, &syntaxErr)) {
+				t.Fatalf("got %v, want a SyntaxError", err)
+			}
+			if syntaxErr.Pos != test.want {
+				t.Errorf("error at %s, want %s", syntaxErr.Pos, test.want)
+			}
+			if p.Pending() {
+				t.Error("parser still pending after the error")
+			}
+		})
+	}
+}
+
+func TestParseRecovers(t *testing.T) {
+	p := NewParser()
+	statements, err := p.Parse(`a; b "\q"; c;`)
+	if err == nil {
+		t.Fatal("got no error")
+	}
+	if len(statements) != 1 || statements[0].Args()[0] != "a" {
+		t.Errorf("got %v before the error, want statement a", statements)
+	}
+	statements, err = p.Parse("d;")
+	if err != nil || len(statements) != 1 {
+		t.Errorf("got %v, %v after the error, want statement d", statements, err)
+	}
+}
@@ -0,0 +1,154 @@
+package postgres_db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/xHappyface/school/api/assignments"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+type PostgresAssignmentRepository struct {
+	db                  *School
+	ctxTimeMilliseconds uint
+	logger              *logger.SchoolLogger
+}
+
+func NewPostgresAssignmentRepository(db *School, milliseconds uint, l *logger.SchoolLogger) *PostgresAssignmentRepository {
+	return &PostgresAssignmentRepository{
+		db:                  db,
+		ctxTimeMilliseconds: milliseconds,
+		logger:              l,
+	}
+}
+
+func (repo *PostgresAssignmentRepository) Assign(ctx context.Context, cfg *assignments.Assignment) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "insert into assignments(professor_id, course_id, role) values ($1, $2, $3);")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, cfg.ProfessorID, cfg.CourseID, cfg.Role)
+	if err != nil {
+		return duplicateKey(err)
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor assigned")
+	return nil
+}
+
+func (repo *PostgresAssignmentRepository) Unassign(ctx context.Context, professorID string, courseID string) error {
+	if !(validID(professorID) && validID(courseID)) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "delete from assignments where professor_id=$1 and course_id=$2;")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, professorID, courseID)
+	if err != nil {
+		return err
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor unassigned")
+	return nil
+}
+
+func (repo *PostgresAssignmentRepository) ListByProfessor(ctx context.Context, professorID string) ([]*assignments.Assignment, error) {
+	if !(validID(professorID)) {
+		return []*assignments.Assignment{}, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "select professor_id, course_id, role from assignments where professor_id=$1;")
+	if err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, professorID)
+	if err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*assignments.Assignment{}
+	for rows.Next() {
+		assignment := new(assignments.Assignment)
+		err = rows.Scan(&assignment.ProfessorID, &assignment.CourseID, &assignment.Role)
+		if err != nil {
+			return []*assignments.Assignment{}, err
+		}
+		list = append(list, assignment)
+	}
+	if err = rows.Err(); err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "assignments retrieved")
+	return list, nil
+}
+
+func (repo *PostgresAssignmentRepository) ListByCourse(ctx context.Context, courseID string) ([]*assignments.Assignment, error) {
+	if !(validID(courseID)) {
+		return []*assignments.Assignment{}, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "select professor_id, course_id, role from assignments where course_id=$1;")
+	if err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, courseID)
+	if err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*assignments.Assignment{}
+	for rows.Next() {
+		assignment := new(assignments.Assignment)
+		err = rows.Scan(&assignment.ProfessorID, &assignment.CourseID, &assignment.Role)
+		if err != nil {
+			return []*assignments.Assignment{}, err
+		}
+		list = append(list, assignment)
+	}
+	if err = rows.Err(); err != nil {
+		return []*assignments.Assignment{}, err
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "assignments retrieved")
+	return list, nil
+}
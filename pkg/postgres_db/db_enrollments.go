@@ -0,0 +1,160 @@
+package postgres_db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/enrollments"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+type PostgresEnrollmentRepository struct {
+	db                  *School
+	ctxTimeMilliseconds uint
+	logger              *logger.SchoolLogger
+}
+
+func NewPostgresEnrollmentRepository(db *School, milliseconds uint, l *logger.SchoolLogger) *PostgresEnrollmentRepository {
+	return &PostgresEnrollmentRepository{
+		db:                  db,
+		ctxTimeMilliseconds: milliseconds,
+		logger:              l,
+	}
+}
+
+func (repo *PostgresEnrollmentRepository) Enroll(ctx context.Context, cfg *enrollments.Enrollment) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "insert into enrollments(student_id, course_id) values ($1, $2);")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, cfg.StudentID, cfg.CourseID)
+	if err != nil {
+		return duplicateKey(err)
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student enrolled")
+	return nil
+}
+
+func (repo *PostgresEnrollmentRepository) Drop(ctx context.Context, studentID string, courseID string) error {
+	if !(validID(studentID) && validID(courseID)) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "delete from enrollments where student_id=$1 and course_id=$2;")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, studentID, courseID)
+	if err != nil {
+		return err
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student dropped")
+	return nil
+}
+
+func (repo *PostgresEnrollmentRepository) ListCoursesByStudent(ctx context.Context, studentID string) ([]*courses.Course, error) {
+	if !(validID(studentID)) {
+		return []*courses.Course{}, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, `select c.id, c.name from courses c
+										join enrollments e on e.course_id=c.id
+										where e.student_id=$1 order by c.name;`)
+	if err != nil {
+		return []*courses.Course{}, err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, studentID)
+	if err != nil {
+		return []*courses.Course{}, err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*courses.Course{}
+	for rows.Next() {
+		course := new(courses.Course)
+		err = rows.Scan(&course.ID, &course.Name)
+		if err != nil {
+			return []*courses.Course{}, err
+		}
+		list = append(list, course)
+	}
+	if err = rows.Err(); err != nil {
+		return []*courses.Course{}, err
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "courses retrieved")
+	return list, nil
+}
+
+func (repo *PostgresEnrollmentRepository) ListStudentsByCourse(ctx context.Context, courseID string) ([]*students.Student, error) {
+	if !(validID(courseID)) {
+		return []*students.Student{}, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, `select s.id, s.name, s.age, s.address, s.phone, s.if_international, s.if_on_probation from students s
+										join enrollments e on e.student_id=s.id
+										where e.course_id=$1 order by s.name;`)
+	if err != nil {
+		return []*students.Student{}, err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, courseID)
+	if err != nil {
+		return []*students.Student{}, err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*students.Student{}
+	for rows.Next() {
+		student := new(students.Student)
+		err = rows.Scan(&student.ID, &student.Name, &student.Age, &student.Address, &student.Phone, &student.IfInternational, &student.IfOnProbation)
+		if err != nil {
+			return []*students.Student{}, err
+		}
+		list = append(list, student)
+	}
+	if err = rows.Err(); err != nil {
+		return []*students.Student{}, err
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "students retrieved")
+	return list, nil
+}
@@ -0,0 +1,261 @@
+package postgres_db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/students"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/internal/sqldb"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+type PostgresStudentRepository struct {
+	db                  *School
+	ctxTimeMilliseconds uint
+	logger              *logger.SchoolLogger
+}
+
+func NewPostgresStudentRepository(db *School, milliseconds uint, l *logger.SchoolLogger) *PostgresStudentRepository {
+	return &PostgresStudentRepository{
+		db:                  db,
+		ctxTimeMilliseconds: milliseconds,
+		logger:              l,
+	}
+}
+
+func (repo *PostgresStudentRepository) Create(ctx context.Context, cfg *students.Student) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, `insert into students(id, name, age, address, phone, if_international, if_on_probation)
+										values ($1, $2, $3, $4, $5, $6, $7);`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name, cfg.Age, cfg.Address, cfg.Phone, cfg.IfInternational, cfg.IfOnProbation)
+	if err != nil {
+		return duplicateKey(err)
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student created")
+	return nil
+}
+
+func (repo *PostgresStudentRepository) ReadByID(ctx context.Context, id string) (*students.Student, error) {
+	if !(validID(id)) {
+		return new(students.Student), mysql_db.ErrZeroRowsRetrieved
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "select id, name, age, address, phone, if_international, if_on_probation from students where id=$1;")
+	if err != nil {
+		return new(students.Student), err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, id)
+	if err != nil {
+		return new(students.Student), err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	student := new(students.Student)
+	for rows.Next() {
+		err = rows.Scan(&student.ID, &student.Name, &student.Age, &student.Address, &student.Phone, &student.IfInternational, &student.IfOnProbation)
+		if err != nil {
+			return new(students.Student), err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return new(students.Student), err
+	}
+	if student.ID == "" {
+		return new(students.Student), mysql_db.ErrZeroRowsRetrieved
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student retrieved")
+	return student, nil
+}
+
+func (repo *PostgresStudentRepository) ReadByName(ctx context.Context, name string) (*students.Student, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "select id, name, age, address, phone, if_international, if_on_probation from students where name=$1;")
+	if err != nil {
+		return new(students.Student), err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, name)
+	if err != nil {
+		return new(students.Student), err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	student := new(students.Student)
+	for rows.Next() {
+		err = rows.Scan(&student.ID, &student.Name, &student.Age, &student.Address, &student.Phone, &student.IfInternational, &student.IfOnProbation)
+		if err != nil {
+			return new(students.Student), err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return new(students.Student), err
+	}
+	if student.ID == "" {
+		return new(students.Student), mysql_db.ErrZeroRowsRetrieved
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student retrieved")
+	return student, nil
+}
+
+// List returns one page of the students matching filter and the cursor of the next page, empty on the last page.
+func (repo *PostgresStudentRepository) List(ctx context.Context, filter *students.Filter, page *pagination.Page) ([]*students.Student, string, error) {
+	sort, err := page.SortKey(pagination.SORT_NAME, pagination.SORT_AGE)
+	if err != nil {
+		return []*students.Student{}, "", err
+	}
+	after, err := page.After()
+	if err != nil {
+		return []*students.Student{}, "", err
+	}
+	q := sqldb.NewListQuery(sqldb.PLACEHOLDER_DOLLAR)
+	q.NamePrefix(filter.NamePrefix)
+	if filter.MinAge > 0 {
+		q.Where("age >= ?", filter.MinAge)
+	}
+	if filter.MaxAge > 0 {
+		q.Where("age <= ?", filter.MaxAge)
+	}
+	if filter.IfInternational != nil {
+		q.Where("if_international = ?", *filter.IfInternational)
+	}
+	if filter.IfOnProbation != nil {
+		q.Where("if_on_probation = ?", *filter.IfOnProbation)
+	}
+	if after != nil {
+		var value any
+		if value, err = after.Value(sort); err != nil {
+			return []*students.Student{}, "", err
+		}
+		q.After(sort, value, after.ID)
+	}
+	query, args := q.Build("select id, name, age, address, phone, if_international, if_on_probation from students", sort, page.Size()+1)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, query)
+	if err != nil {
+		return []*students.Student{}, "", err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return []*students.Student{}, "", err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*students.Student{}
+	for rows.Next() {
+		student := new(students.Student)
+		err = rows.Scan(&student.ID, &student.Name, &student.Age, &student.Address, &student.Phone, &student.IfInternational, &student.IfOnProbation)
+		if err != nil {
+			return []*students.Student{}, "", err
+		}
+		list = append(list, student)
+	}
+	if err = rows.Err(); err != nil {
+		return []*students.Student{}, "", err
+	}
+	var next string
+	if uint(len(list)) > page.Size() {
+		list = list[:page.Size()]
+		next = list[len(list)-1].Cursor(sort).String()
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "students retrieved")
+	return list, next, nil
+}
+
+func (repo *PostgresStudentRepository) Update(ctx context.Context, cfg *students.Student) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if !(validID(cfg.ID)) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "update students set id=$1, name=$2, age=$3, address=$4, phone=$5, if_international=$6, if_on_probation=$7 where id=$8;")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name, cfg.Age, cfg.Address, cfg.Phone, cfg.IfInternational, cfg.IfOnProbation, cfg.ID)
+	if err != nil {
+		return duplicateKey(err)
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student updated")
+	return nil
+}
+
+func (repo *PostgresStudentRepository) DeleteByID(ctx context.Context, id string) error {
+	if !(validID(id)) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "delete from students where id=$1;")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, id)
+	if err != nil {
+		return err
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "student deleted")
+	return nil
+}
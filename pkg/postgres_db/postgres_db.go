@@ -0,0 +1,128 @@
+package postgres_db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/internal/sqldb"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+const (
+	// UNIQUE_VIOLATION is the postgres error code of a row violating a primary key or unique index.
+	UNIQUE_VIOLATION pq.ErrorCode = "23505"
+
+	LOG_PREPARING_STMT = "preparing sql statement..."
+	LOG_EXECUTING_STMT = "executing sql statement..."
+
+	schema = `
+create table if not exists courses (
+	id   uuid primary key,
+	name text not null
+);
+create table if not exists professors (
+	id                uuid primary key,
+	name              text not null,
+	age               smallint not null,
+	address           text not null,
+	phone             bigint not null,
+	salary            double precision not null,
+	if_received_bonus boolean not null default false
+);
+create table if not exists students (
+	id               uuid primary key,
+	name             text not null,
+	age              smallint not null,
+	address          text not null,
+	phone            bigint not null,
+	if_international boolean not null default false,
+	if_on_probation  boolean not null default false
+);
+create table if not exists enrollments (
+	student_id uuid not null references students(id) on delete cascade,
+	course_id  uuid not null references courses(id) on delete cascade,
+	primary key (student_id, course_id)
+);
+create table if not exists assignments (
+	professor_id uuid not null references professors(id) on delete cascade,
+	course_id    uuid not null references courses(id) on delete cascade,
+	role         text not null,
+	primary key (professor_id, course_id)
+);
+create unique index if not exists courses_name_unique on courses(name);
+create unique index if not exists professors_name_unique on professors(name);
+create unique index if not exists students_name_unique on students(name);`
+)
+
+type School struct {
+	conn   *sqldb.Conn
+	school sqldb.Querier
+}
+
+// NewSchoolDB connects to the postgres database at source, or to the local school database
+// with DB_USER and DB_PASS when source is empty, creating its tables if they do not exist yet.
+func NewSchoolDB(l *logger.SchoolLogger, source string) (*School, error) {
+	db, err := connect(l, source)
+	if err != nil {
+		return new(School), err
+	}
+	l.Log(logger.LOG_LEVEL_INFO, "creating schema...")
+	if _, err = db.Exec(schema); err != nil {
+		db.Close()
+		return new(School), err
+	}
+	return &School{conn: sqldb.NewConn(db), school: db}, nil
+}
+
+func connect(l *logger.SchoolLogger, source string) (*sql.DB, error) {
+	l.Log(logger.LOG_LEVEL_INFO, "connecting to postgres database...")
+	if source == "" {
+		user := os.Getenv("DB_USER")
+		pass := os.Getenv("DB_PASS")
+		// a url escapes any character of the credentials, unlike quoting them in a key=value string
+		local := &url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(user, pass),
+			Host:     "localhost:5432",
+			Path:     "school",
+			RawQuery: "sslmode=disable",
+		}
+		source = local.String()
+	}
+	db, err := sql.Open("postgres", source)
+	if err != nil {
+		return new(sql.DB), err
+	}
+	if err = db.Ping(); err != nil {
+		return new(sql.DB), err
+	}
+	l.Log(logger.LOG_LEVEL_INFO, "connected to database")
+	return db, nil
+}
+
+// Close closes the connection pool, a School bound to a transaction leaves that to the School it began from.
+func (schoolDB *School) Close() error {
+	return schoolDB.conn.Close()
+}
+
+// validID reports whether id can be compared against a uuid column;
+// postgres rejects the whole statement otherwise.
+func validID(id string) bool {
+	_, err := uuid.Parse(id)
+	return err == nil
+}
+
+// duplicateKey wraps a primary key or unique index violation in mysql_db.ErrDuplicateKey, leaving other errors as they are.
+func duplicateKey(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == UNIQUE_VIOLATION {
+		return fmt.Errorf("%w: %s", mysql_db.ErrDuplicateKey, err)
+	}
+	return err
+}
@@ -0,0 +1,239 @@
+package sqlite_db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/xHappyface/school/api/courses"
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/internal/sqldb"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+type SQLiteCourseRepository struct {
+	db                  *School
+	ctxTimeMilliseconds uint
+	logger              *logger.SchoolLogger
+}
+
+func NewSQLiteCourseRepository(db *School, milliseconds uint, l *logger.SchoolLogger) *SQLiteCourseRepository {
+	return &SQLiteCourseRepository{
+		db:                  db,
+		ctxTimeMilliseconds: milliseconds,
+		logger:              l,
+	}
+}
+
+func (repo *SQLiteCourseRepository) Create(ctx context.Context, cfg *courses.Course) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "insert into courses(id, name) values (?, ?);")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name)
+	if err != nil {
+		return duplicateKey(err)
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "course created")
+	return nil
+}
+
+func (repo *SQLiteCourseRepository) ReadByID(ctx context.Context, id string) (*courses.Course, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "select id, name from courses where id=?;")
+	if err != nil {
+		return new(courses.Course), err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, id)
+	if err != nil {
+		return new(courses.Course), err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	course := new(courses.Course)
+	for rows.Next() {
+		err = rows.Scan(&course.ID, &course.Name)
+		if err != nil {
+			return new(courses.Course), err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return new(courses.Course), err
+	}
+	if course.ID == "" {
+		return new(courses.Course), mysql_db.ErrZeroRowsRetrieved
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "course retrieved")
+	return course, nil
+}
+
+func (repo *SQLiteCourseRepository) ReadByName(ctx context.Context, name string) (*courses.Course, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "select id, name from courses where name=?;")
+	if err != nil {
+		return new(courses.Course), err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, name)
+	if err != nil {
+		return new(courses.Course), err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	course := new(courses.Course)
+	for rows.Next() {
+		err = rows.Scan(&course.ID, &course.Name)
+		if err != nil {
+			return new(courses.Course), err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return new(courses.Course), err
+	}
+	if course.ID == "" {
+		return new(courses.Course), mysql_db.ErrZeroRowsRetrieved
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "course retrieved")
+	return course, nil
+}
+
+// List returns one page of the courses matching filter and the cursor of the next page, empty on the last page.
+func (repo *SQLiteCourseRepository) List(ctx context.Context, filter *courses.Filter, page *pagination.Page) ([]*courses.Course, string, error) {
+	sort, err := page.SortKey(pagination.SORT_NAME)
+	if err != nil {
+		return []*courses.Course{}, "", err
+	}
+	after, err := page.After()
+	if err != nil {
+		return []*courses.Course{}, "", err
+	}
+	q := sqldb.NewListQuery(sqldb.PLACEHOLDER_QUESTION)
+	q.NamePrefix(filter.NamePrefix)
+	if after != nil {
+		var value any
+		if value, err = after.Value(sort); err != nil {
+			return []*courses.Course{}, "", err
+		}
+		q.After(sort, value, after.ID)
+	}
+	query, args := q.Build("select id, name from courses", sort, page.Size()+1)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, query)
+	if err != nil {
+		return []*courses.Course{}, "", err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return []*courses.Course{}, "", err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*courses.Course{}
+	for rows.Next() {
+		course := new(courses.Course)
+		err = rows.Scan(&course.ID, &course.Name)
+		if err != nil {
+			return []*courses.Course{}, "", err
+		}
+		list = append(list, course)
+	}
+	if err = rows.Err(); err != nil {
+		return []*courses.Course{}, "", err
+	}
+	var next string
+	if uint(len(list)) > page.Size() {
+		list = list[:page.Size()]
+		next = list[len(list)-1].Cursor(sort).String()
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "courses retrieved")
+	return list, next, nil
+}
+
+func (repo *SQLiteCourseRepository) Update(ctx context.Context, cfg *courses.Course) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "update courses set id=?, name=? where id=?;")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name, cfg.ID)
+	if err != nil {
+		return duplicateKey(err)
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "course updated")
+	return nil
+}
+
+func (repo *SQLiteCourseRepository) DeleteByID(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "delete from courses where id=?;")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, id)
+	if err != nil {
+		return err
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "course deleted")
+	return nil
+}
@@ -0,0 +1,255 @@
+package sqlite_db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/xHappyface/school/api/pagination"
+	"github.com/xHappyface/school/api/professors"
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/internal/sqldb"
+	"github.com/xHappyface/school/pkg/mysql_db"
+)
+
+type SQLiteProfessorRepository struct {
+	db                  *School
+	ctxTimeMilliseconds uint
+	logger              *logger.SchoolLogger
+}
+
+func NewSQLiteProfessorRepository(db *School, milliseconds uint, l *logger.SchoolLogger) *SQLiteProfessorRepository {
+	return &SQLiteProfessorRepository{
+		db:                  db,
+		ctxTimeMilliseconds: milliseconds,
+		logger:              l,
+	}
+}
+
+func (repo *SQLiteProfessorRepository) Create(ctx context.Context, cfg *professors.Professor) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, `insert into professors(id, name, age, address, phone, salary, if_received_bonus)
+										values (?, ?, ?, ?, ?, ?, ?);`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name, cfg.Age, cfg.Address, cfg.Phone, cfg.Salary, cfg.IfReceivedBonus)
+	if err != nil {
+		return duplicateKey(err)
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor created")
+	return nil
+}
+
+func (repo *SQLiteProfessorRepository) ReadByID(ctx context.Context, id string) (*professors.Professor, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "select id, name, age, address, phone, salary, if_received_bonus from professors where id=?;")
+	if err != nil {
+		return new(professors.Professor), err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, id)
+	if err != nil {
+		return new(professors.Professor), err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	professor := new(professors.Professor)
+	for rows.Next() {
+		err = rows.Scan(&professor.ID, &professor.Name, &professor.Age, &professor.Address, &professor.Phone, &professor.Salary, &professor.IfReceivedBonus)
+		if err != nil {
+			return new(professors.Professor), err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.ID == "" {
+		return new(professors.Professor), mysql_db.ErrZeroRowsRetrieved
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor retrieved")
+	return professor, nil
+}
+
+func (repo *SQLiteProfessorRepository) ReadByName(ctx context.Context, name string) (*professors.Professor, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "select id, name, age, address, phone, salary, if_received_bonus from professors where name=?;")
+	if err != nil {
+		return new(professors.Professor), err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, name)
+	if err != nil {
+		return new(professors.Professor), err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	professor := new(professors.Professor)
+	for rows.Next() {
+		err = rows.Scan(&professor.ID, &professor.Name, &professor.Age, &professor.Address, &professor.Phone, &professor.Salary, &professor.IfReceivedBonus)
+		if err != nil {
+			return new(professors.Professor), err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return new(professors.Professor), err
+	}
+	if professor.ID == "" {
+		return new(professors.Professor), mysql_db.ErrZeroRowsRetrieved
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor retrieved")
+	return professor, nil
+}
+
+// List returns one page of the professors matching filter and the cursor of the next page, empty on the last page.
+func (repo *SQLiteProfessorRepository) List(ctx context.Context, filter *professors.Filter, page *pagination.Page) ([]*professors.Professor, string, error) {
+	sort, err := page.SortKey(pagination.SORT_NAME, pagination.SORT_AGE, pagination.SORT_SALARY)
+	if err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	after, err := page.After()
+	if err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	q := sqldb.NewListQuery(sqldb.PLACEHOLDER_QUESTION)
+	q.NamePrefix(filter.NamePrefix)
+	if filter.MinAge > 0 {
+		q.Where("age >= ?", filter.MinAge)
+	}
+	if filter.MaxAge > 0 {
+		q.Where("age <= ?", filter.MaxAge)
+	}
+	if filter.MinSalary != nil {
+		q.Where("salary >= ?", *filter.MinSalary)
+	}
+	if filter.MaxSalary != nil {
+		q.Where("salary <= ?", *filter.MaxSalary)
+	}
+	if filter.IfReceivedBonus != nil {
+		q.Where("if_received_bonus = ?", *filter.IfReceivedBonus)
+	}
+	if after != nil {
+		var value any
+		if value, err = after.Value(sort); err != nil {
+			return []*professors.Professor{}, "", err
+		}
+		q.After(sort, value, after.ID)
+	}
+	query, args := q.Build("select id, name, age, address, phone, salary, if_received_bonus from professors", sort, page.Size()+1)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, query)
+	if err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var rows *sql.Rows
+	rows, err = stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	defer rows.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "scanning rows...")
+	list := []*professors.Professor{}
+	for rows.Next() {
+		professor := new(professors.Professor)
+		err = rows.Scan(&professor.ID, &professor.Name, &professor.Age, &professor.Address, &professor.Phone, &professor.Salary, &professor.IfReceivedBonus)
+		if err != nil {
+			return []*professors.Professor{}, "", err
+		}
+		list = append(list, professor)
+	}
+	if err = rows.Err(); err != nil {
+		return []*professors.Professor{}, "", err
+	}
+	var next string
+	if uint(len(list)) > page.Size() {
+		list = list[:page.Size()]
+		next = list[len(list)-1].Cursor(sort).String()
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professors retrieved")
+	return list, next, nil
+}
+
+func (repo *SQLiteProfessorRepository) Update(ctx context.Context, cfg *professors.Professor) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "update professors set id=?, name=?, age=?, address=?, phone=?, salary=?, if_received_bonus=? where id=?;")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, cfg.ID, cfg.Name, cfg.Age, cfg.Address, cfg.Phone, cfg.Salary, cfg.IfReceivedBonus, cfg.ID)
+	if err != nil {
+		return duplicateKey(err)
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor updated")
+	return nil
+}
+
+func (repo *SQLiteProfessorRepository) DeleteByID(ctx context.Context, id string) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(repo.ctxTimeMilliseconds*uint(time.Millisecond)))
+	defer cancel()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_PREPARING_STMT)
+	stmt, err := repo.db.school.PrepareContext(ctx, "delete from professors where id=?;")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, LOG_EXECUTING_STMT)
+	var result sql.Result
+	result, err = stmt.ExecContext(ctx, id)
+	if err != nil {
+		return err
+	}
+	var affected int64
+	affected, err = result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if !(affected > 0) {
+		return mysql_db.ErrZeroRowsAffected
+	}
+	repo.logger.LogContext(ctx, logger.LOG_LEVEL_INFO, "professor deleted")
+	return nil
+}
@@ -0,0 +1,108 @@
+package sqlite_db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/xHappyface/school/logger"
+	"github.com/xHappyface/school/pkg/internal/sqldb"
+	"github.com/xHappyface/school/pkg/mysql_db"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const (
+	LOG_PREPARING_STMT = "preparing sql statement..."
+	LOG_EXECUTING_STMT = "executing sql statement..."
+
+	schema = `
+create table if not exists courses (
+	id   text primary key,
+	name text not null
+);
+create table if not exists professors (
+	id                text primary key,
+	name              text not null,
+	age               integer not null,
+	address           text not null,
+	phone             integer not null,
+	salary            real not null,
+	if_received_bonus integer not null default 0
+);
+create table if not exists students (
+	id               text primary key,
+	name             text not null,
+	age              integer not null,
+	address          text not null,
+	phone            integer not null,
+	if_international integer not null default 0,
+	if_on_probation  integer not null default 0
+);
+create table if not exists enrollments (
+	student_id text not null references students(id) on delete cascade,
+	course_id  text not null references courses(id) on delete cascade,
+	primary key (student_id, course_id)
+);
+create table if not exists assignments (
+	professor_id text not null references professors(id) on delete cascade,
+	course_id    text not null references courses(id) on delete cascade,
+	role         text not null,
+	primary key (professor_id, course_id)
+);
+create unique index if not exists courses_name_unique on courses(name);
+create unique index if not exists professors_name_unique on professors(name);
+create unique index if not exists students_name_unique on students(name);`
+)
+
+type School struct {
+	conn   *sqldb.Conn
+	school sqldb.Querier
+}
+
+// NewSchoolDB opens the sqlite database file at path, creating the file and its tables if they do not exist yet.
+func NewSchoolDB(l *logger.SchoolLogger, path string) (*School, error) {
+	db, err := connect(l, path)
+	if err != nil {
+		return new(School), err
+	}
+	l.Log(logger.LOG_LEVEL_INFO, "creating schema...")
+	if _, err = db.Exec(schema); err != nil {
+		db.Close()
+		return new(School), err
+	}
+	return &School{conn: sqldb.NewConn(db), school: db}, nil
+}
+
+func connect(l *logger.SchoolLogger, path string) (*sql.DB, error) {
+	l.Log(logger.LOG_LEVEL_INFO, "opening sqlite database...")
+	// transactions take the write lock as they begin, so that one reading before it writes
+	// waits out the busy timeout for another writer instead of failing to upgrade its lock
+	source := fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_txlock=immediate", path)
+	db, err := sql.Open("sqlite", source)
+	if err != nil {
+		return new(sql.DB), err
+	}
+	if err = db.Ping(); err != nil {
+		return new(sql.DB), err
+	}
+	l.Log(logger.LOG_LEVEL_INFO, "opened database")
+	return db, nil
+}
+
+// Close closes the connection pool, a School bound to a transaction leaves that to the School it began from.
+func (schoolDB *School) Close() error {
+	return schoolDB.conn.Close()
+}
+
+// duplicateKey wraps a primary key or unique index violation in mysql_db.ErrDuplicateKey, leaving other errors as they are.
+func duplicateKey(err error) error {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() {
+		case sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY, sqlite3.SQLITE_CONSTRAINT_UNIQUE:
+			return fmt.Errorf("%w: %s", mysql_db.ErrDuplicateKey, err)
+		}
+	}
+	return err
+}
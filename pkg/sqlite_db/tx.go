@@ -0,0 +1,21 @@
+package sqlite_db
+
+import "context"
+
+// Begin starts a transaction and returns a School bound to it,
+// repositories built on the returned School run inside the transaction.
+func (schoolDB *School) Begin(ctx context.Context) (*School, error) {
+	conn, err := schoolDB.conn.Begin(ctx)
+	if err != nil {
+		return new(School), err
+	}
+	return &School{conn: conn, school: conn.Querier()}, nil
+}
+
+func (schoolDB *School) Commit() error {
+	return schoolDB.conn.Commit()
+}
+
+func (schoolDB *School) Rollback() error {
+	return schoolDB.conn.Rollback()
+}